@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -9,10 +10,18 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		Db   `yaml:"database"`
-		Log  `yaml:"logger"`
+		App       `yaml:"app"`
+		HTTP      `yaml:"http"`
+		GRPC      `yaml:"grpc"`
+		Db        `yaml:"database"`
+		Log       `yaml:"logger"`
+		Currency  `yaml:"currency"`
+		Locale    `yaml:"locale"`
+		Scheduler `yaml:"scheduler"`
+		Storage   `yaml:"storage"`
+		Image     `yaml:"image"`
+		Stock     `yaml:"stock"`
+		Export    `yaml:"export"`
 	}
 
 	// App
@@ -22,7 +31,13 @@ type (
 
 	// HTTP-Server
 	HTTP struct {
-		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		Port      string `env-required:"true"   yaml:"port"       env:"HTTP_PORT"`
+		BodyLimit int    `env-default:"8388608" yaml:"body_limit" env:"HTTP_BODY_LIMIT"` // bytes, must fit the max image size
+	}
+
+	// gRPC-Server
+	GRPC struct {
+		Port string `env-required:"true" yaml:"port" env:"GRPC_PORT"`
 	}
 
 	// Log
@@ -30,6 +45,54 @@ type (
 		Level string `env-required:"true" yaml:"log_level"   env:"LOG_LEVEL"`
 	}
 
+	// Currency of item details and rounding of converted prices
+	Currency struct {
+		Default  string `env-default:"THB"     yaml:"default"  env:"CURRENCY_DEFAULT"`
+		Rounding string `env-default:"half_up" yaml:"rounding" env:"CURRENCY_ROUNDING"`
+		Decimals int    `env-default:"2"       yaml:"decimals" env:"CURRENCY_DECIMALS"`
+	}
+
+	// Locale of item, category and group names, names without translation are in the default locale
+	Locale struct {
+		Default   string   `env-default:"th"       yaml:"default"   env:"LOCALE_DEFAULT"`
+		Supported []string `env-default:"th,en,zh" yaml:"supported" env:"LOCALE_SUPPORTED" env-separator:","`
+	}
+
+	// Scheduler of the background jobs
+	Scheduler struct {
+		PriceInterval time.Duration `env-default:"10s" yaml:"price_interval" env:"SCHEDULER_PRICE_INTERVAL"`
+	}
+
+	// Storage of the item images, local directory or S3 compatible bucket
+	Storage struct {
+		Driver      string `env-default:"local"        yaml:"driver"        env:"STORAGE_DRIVER"` // local or s3
+		LocalDir    string `env-default:"./data/blobs" yaml:"local_dir"     env:"STORAGE_LOCAL_DIR"`
+		S3Endpoint  string `yaml:"s3_endpoint"   env:"STORAGE_S3_ENDPOINT"` // host[:port], e.g. localhost:9000 for MinIO
+		S3Region    string `yaml:"s3_region"     env:"STORAGE_S3_REGION"`
+		S3Bucket    string `yaml:"s3_bucket"     env:"STORAGE_S3_BUCKET"`
+		S3AccessKey string `yaml:"s3_access_key" env:"STORAGE_S3_ACCESS_KEY"`
+		S3SecretKey string `yaml:"s3_secret_key" env:"STORAGE_S3_SECRET_KEY"`
+		S3UseSSL    bool   `yaml:"s3_use_ssl"    env:"STORAGE_S3_USE_SSL"`
+		S3PathStyle bool   `yaml:"s3_path_style" env:"STORAGE_S3_PATH_STYLE"` // needed by MinIO without DNS setup
+	}
+
+	// Image limits of the uploaded item images and size of their thumbnails
+	Image struct {
+		MaxSize       int64 `env-default:"5242880"  yaml:"max_size"       env:"IMAGE_MAX_SIZE"` // bytes
+		MaxPixels     int   `env-default:"40000000" yaml:"max_pixels"     env:"IMAGE_MAX_PIXELS"`
+		ThumbnailSize int   `env-default:"320"      yaml:"thumbnail_size" env:"IMAGE_THUMBNAIL_SIZE"`
+	}
+
+	// Stock policy of the movements taking the balance below zero
+	Stock struct {
+		NegativePolicy string `env-default:"reject" yaml:"negative_policy" env:"STOCK_NEGATIVE_POLICY"` // allow or reject
+	}
+
+	// Export of the item details, each running export holds a database connection
+	Export struct {
+		MaxConcurrent int `env-default:"1" yaml:"max_concurrent" env:"EXPORT_MAX_CONCURRENT"` // less than the pool size
+	}
+
 	// DB Postgres
 	Db struct {
 		PgURL       string `env-required:"true" yaml:"pg_url" env:"PG_URL"`
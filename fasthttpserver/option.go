@@ -25,3 +25,10 @@ func IdleTimeout(t time.Duration) Option {
 		s.server.IdleTimeout = t
 	}
 }
+
+// MaxRequestBodySize -.
+func MaxRequestBodySize(size int) Option {
+	return func(s *Server) {
+		s.server.MaxRequestBodySize = size
+	}
+}
@@ -0,0 +1,24 @@
+package grpcserver
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Option -.
+type Option func(*Server)
+
+// ServerOptions adds options of the grpc server, e.g. interceptors.
+func ServerOptions(opts ...grpc.ServerOption) Option {
+	return func(s *Server) {
+		s.serverOptions = append(s.serverOptions, opts...)
+	}
+}
+
+// ShutdownTimeout -.
+func ShutdownTimeout(t time.Duration) Option {
+	return func(s *Server) {
+		s.shutdownTimeout = t
+	}
+}
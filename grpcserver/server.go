@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	_defaultAddr            = ":9090"
+	_defaultShutdownTimeout = 3 * time.Second
+)
+
+// Server -.
+type Server struct {
+	server          *grpc.Server
+	serverOptions   []grpc.ServerOption
+	shutdownTimeout time.Duration
+	notify          chan error
+}
+
+// New creates the gRPC server, register registers the services on it before the server starts.
+func New(register func(*grpc.Server), port string, opts ...Option) *Server {
+	s := &Server{
+		shutdownTimeout: _defaultShutdownTimeout,
+		notify:          make(chan error, 1),
+	}
+
+	// custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.server = grpc.NewServer(s.serverOptions...)
+	register(s.server)
+
+	s.start(port)
+
+	return s
+}
+
+func (s *Server) start(port string) {
+	addr := _defaultAddr
+	if port != "" {
+		addr = ":" + port
+	}
+
+	go func() {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			s.notify <- err
+			return
+		}
+		s.notify <- s.server.Serve(lis)
+	}()
+}
+
+func (s *Server) Notify() <-chan error {
+	return s.notify
+}
+
+// Shutdown waits for the running calls to finish, they are cancelled after the shutdown timeout.
+func (s *Server) Shutdown() {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(s.shutdownTimeout):
+		s.server.Stop()
+	}
+}
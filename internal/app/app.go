@@ -1,19 +1,27 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/lmnq/test-thai/config"
 	"github.com/lmnq/test-thai/database/postgres"
 	"github.com/lmnq/test-thai/fasthttpserver"
+	"github.com/lmnq/test-thai/grpcserver"
 	"github.com/lmnq/test-thai/internal/controller"
+	grpcv1 "github.com/lmnq/test-thai/internal/controller/grpc/v1"
+	"github.com/lmnq/test-thai/internal/model"
 	"github.com/lmnq/test-thai/internal/repo"
 	"github.com/lmnq/test-thai/internal/service"
 	"github.com/lmnq/test-thai/logger"
+	"github.com/lmnq/test-thai/scheduler"
+	"github.com/lmnq/test-thai/storage"
+	"google.golang.org/grpc"
 )
 
 // Run configures and runs the app -.
@@ -33,16 +41,92 @@ func Run(cfg *config.Config) {
 	// migrate - init database migration
 	// database.Migrate(cfg.Db.PgURL)
 
+	// storage - init blob storage of item images
+	var blobs storage.Storage
+	switch cfg.Storage.Driver {
+	case "local":
+		blobs, err = storage.NewLocal(cfg.Storage.LocalDir)
+	case "s3":
+		blobs, err = storage.NewS3(context.Background(), storage.S3Options{
+			Endpoint:  cfg.Storage.S3Endpoint,
+			Region:    cfg.Storage.S3Region,
+			Bucket:    cfg.Storage.S3Bucket,
+			AccessKey: cfg.Storage.S3AccessKey,
+			SecretKey: cfg.Storage.S3SecretKey,
+			UseSSL:    cfg.Storage.S3UseSSL,
+			PathStyle: cfg.Storage.S3PathStyle,
+		})
+	default:
+		err = fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+	if err != nil {
+		l.Fatal("storage error", err)
+	}
+
 	// repos
 	repos := repo.New(pg)
 
 	// services
-	services := service.New(repos)
+	currency := service.CurrencyOptions{
+		Default: cfg.Currency.Default,
+		Rounding: model.Rounding{
+			Mode:     cfg.Currency.Rounding,
+			Decimals: cfg.Currency.Decimals,
+		},
+	}
+	if err := currency.Validate(); err != nil {
+		l.Fatal("currency config error", err)
+	}
+	locale := service.LocaleOptions{
+		Default:   cfg.Locale.Default,
+		Supported: cfg.Locale.Supported,
+	}
+	if err := locale.Validate(); err != nil {
+		l.Fatal("locale config error", err)
+	}
+	image := service.ImageOptions{
+		MaxSize:       cfg.Image.MaxSize,
+		MaxPixels:     cfg.Image.MaxPixels,
+		ThumbnailSize: cfg.Image.ThumbnailSize,
+	}
+	if err := image.Validate(); err != nil {
+		l.Fatal("image config error", err)
+	}
+	stock := service.StockOptions{
+		NegativePolicy: cfg.Stock.NegativePolicy,
+	}
+	if err := stock.Validate(); err != nil {
+		l.Fatal("stock config error", err)
+	}
+	export := service.ExportOptions{
+		MaxConcurrent: cfg.Export.MaxConcurrent,
+	}
+	if err := export.Validate(); err != nil {
+		l.Fatal("export config error", err)
+	}
+	services := service.New(repos, blobs, currency, locale, image, stock, export)
 
 	// HTTP server
 	fiberApp := fiber.New(fiber.Config{AppName: cfg.App.Name})
 	controller.New(fiberApp, l, services)
-	fastHTTPServer := fasthttpserver.New(fiberApp.Handler(), cfg.HTTP.Port)
+	fastHTTPServer := fasthttpserver.New(fiberApp.Handler(), cfg.HTTP.Port, fasthttpserver.MaxRequestBodySize(cfg.HTTP.BodyLimit))
+
+	// gRPC server
+	grpcServer := grpcserver.New(func(s *grpc.Server) {
+		grpcv1.New(s, l, services)
+	}, cfg.GRPC.Port)
+
+	// scheduler - apply scheduled item detail prices when they become effective
+	priceScheduler := scheduler.New(func(ctx context.Context) {
+		applied, myerr := services.ItemDetail.ApplyScheduledPrices(ctx, time.Now())
+		if myerr.IsErr() {
+			l.Error(myerr.Err, "apply scheduled prices error")
+			return
+		}
+		if applied > 0 {
+			l.Info("scheduled prices applied: %d", applied)
+		}
+	}, scheduler.Interval(cfg.Scheduler.PriceInterval))
 
 	// signal
 	interrupt := make(chan os.Signal, 1)
@@ -50,12 +134,16 @@ func Run(cfg *config.Config) {
 
 	select {
 	case s := <-interrupt:
-		l.Info("signal received", s.String())
+		l.Info("signal received: " + s.String())
 	case err = <-fastHTTPServer.Notify():
 		l.Error(fmt.Errorf("fastHTTPServer error: %w", err))
+	case err = <-grpcServer.Notify():
+		l.Error(fmt.Errorf("grpcServer error: %w", err))
 	}
 
 	// shutdown
 	fastHTTPServer.Shutdown()
+	grpcServer.Shutdown()
+	priceScheduler.Shutdown()
 	l.Info("server shutdown")
 }
@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type bundleController struct {
+	s           service.Bundle
+	translation service.Translation
+	l           logger.Logger
+}
+
+func newBundleController(router fiber.Router, l logger.Logger, bundleService service.Bundle, translationService service.Translation) {
+	c := &bundleController{
+		s:           bundleService,
+		translation: translationService,
+		l:           l,
+	}
+
+	r := router.Group("/bundle")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+}
+
+// bundleRequest is the bundle of create and update, update replaces the components.
+type bundleRequest struct {
+	BundleName  string                   `json:"bundle_name"`
+	Currency    string                   `json:"currency"`     // default currency if empty
+	ManualPrice *float64                 `json:"manual_price"` // price is computed from the components if null
+	Components  []bundleComponentRequest `json:"components"`
+}
+
+type bundleComponentRequest struct {
+	ItemDetailID int `json:"item_detail_id"`
+	Quantity     int `json:"quantity"`
+}
+
+func (r bundleRequest) bundle() *model.Bundle {
+	components := make([]*model.BundleComponent, 0, len(r.Components))
+	for _, component := range r.Components {
+		components = append(components, &model.BundleComponent{
+			ItemDetailID: component.ItemDetailID,
+			Quantity:     component.Quantity,
+		})
+	}
+
+	return &model.Bundle{
+		BundleName:  r.BundleName,
+		Currency:    r.Currency,
+		ManualPrice: r.ManualPrice,
+		Components:  components,
+	}
+}
+
+type bundleFilterParams struct {
+	Unavailable *bool `query:"unavailable"` // bundles with deleted component item details
+}
+
+func (c *bundleController) create(ctx *fiber.Ctx) error {
+	var req bundleRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.bundle())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create bundle error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *bundleController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get bundle id param error")
+		return errorResponse(ctx, 400, "get bundle id param error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	bundle, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get bundle error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	if myerr := c.translation.LocalizeBundles(ctx.Context(), locale, bundle); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize bundle error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(bundle)
+}
+
+func (c *bundleController) getAll(ctx *fiber.Ctx) error {
+	var (
+		params     bundleFilterParams
+		pageParams pageParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&pageParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	bundles, pageInfo, myerr := c.s.GetAll(ctx.Context(), &model.BundleFilter{
+		Unavailable: params.Unavailable,
+	}, pageParams.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all bundles error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	if myerr := c.translation.LocalizeBundles(ctx.Context(), locale, bundles...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize bundle list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"bundles":     bundles,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
+
+func (c *bundleController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get bundle id param error")
+		return errorResponse(ctx, 400, "get bundle id param error")
+	}
+
+	var req bundleRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.bundle())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update bundle error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *bundleController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get bundle id param error")
+		return errorResponse(ctx, 400, "get bundle id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete bundle error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -7,23 +7,31 @@ import (
 )
 
 type categoryController struct {
-	s service.Category
-	l logger.Logger
+	s           service.Category
+	translation service.Translation
+	l           logger.Logger
 }
 
-func newCategoryController(router fiber.Router, l logger.Logger, categoryService service.Category) {
+func newCategoryController(router fiber.Router, l logger.Logger, categoryService service.Category, translationService service.Translation) {
 	c := &categoryController{
-		s: categoryService,
-		l: l,
+		s:           categoryService,
+		translation: translationService,
+		l:           l,
 	}
 
 	r := router.Group("/category")
 
 	r.Post("/", c.create)
+	r.Get("/tree", c.getTree)
 	r.Get("/:id", c.get)
 	r.Get("/", c.getAll)
 	r.Put("/:id", c.update)
+	r.Patch("/:id", c.patch)
 	r.Delete("/:id", c.delete)
+	r.Put("/:id/tax-profile", c.setTaxProfile)
+	r.Delete("/:id/tax-profile", c.deleteTaxProfile)
+	r.Put("/:id/parent", c.setParent)
+	r.Delete("/:id/parent", c.deleteParent)
 }
 
 type categoryCreateRequest struct {
@@ -56,24 +64,78 @@ func (c *categoryController) get(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, 400, "get category id param error")
 	}
 
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	category, myerr := c.s.Get(ctx.Context(), id)
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get category error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeCategories(ctx.Context(), locale, category); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize category error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(category)
 }
 
 func (c *categoryController) getAll(ctx *fiber.Ctx) error {
-	categories, myerr := c.s.GetAll(ctx.Context())
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	categories, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get all categories error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeCategories(ctx.Context(), locale, categories...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize category list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"categories":  categories,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
+
+func (c *categoryController) getTree(ctx *fiber.Ctx) error {
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	tree, myerr := c.s.GetTree(ctx.Context())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get category tree error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	if myerr := c.translation.LocalizeCategoryTree(ctx.Context(), locale, tree...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize category tree error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"categories": categories,
+		"categories": tree,
 	})
 }
 
@@ -104,6 +166,39 @@ func (c *categoryController) update(ctx *fiber.Ctx) error {
 	return ctx.SendStatus(fiber.StatusOK)
 }
 
+type categoryPatchRequest struct {
+	CategoryName patchField[string] `json:"category_name"`
+}
+
+func (c *categoryController) patch(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	var req categoryPatchRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	name, err := req.CategoryName.get("category_name")
+	if err != nil {
+		c.l.Error(err, "patch category error")
+		return errorResponse(ctx, 400, err.Error())
+	}
+
+	myerr := c.s.Patch(ctx.Context(), id, name)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch category error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
 func (c *categoryController) delete(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -119,3 +214,100 @@ func (c *categoryController) delete(ctx *fiber.Ctx) error {
 
 	return ctx.SendStatus(fiber.StatusOK)
 }
+
+// categoryTaxProfileRequest attaches the tax profile to the category.
+type categoryTaxProfileRequest struct {
+	TaxProfileID *int `json:"tax_profile_id"`
+}
+
+func (c *categoryController) setTaxProfile(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	var req categoryTaxProfileRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+	if req.TaxProfileID == nil {
+		c.l.Error("tax_profile_id is missing")
+		return errorResponse(ctx, 400, "tax_profile_id is required")
+	}
+
+	myerr := c.s.SetTaxProfile(ctx.Context(), id, req.TaxProfileID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "set category tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *categoryController) deleteTaxProfile(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	myerr := c.s.SetTaxProfile(ctx.Context(), id, nil)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete category tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// categoryParentRequest moves the category under the parent category.
+type categoryParentRequest struct {
+	ParentID *int `json:"parent_id"`
+}
+
+func (c *categoryController) setParent(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	var req categoryParentRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+	if req.ParentID == nil {
+		c.l.Error("parent_id is missing")
+		return errorResponse(ctx, 400, "parent_id is required")
+	}
+
+	myerr := c.s.SetParent(ctx.Context(), id, req.ParentID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "set category parent error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// deleteParent makes the category top level.
+func (c *categoryController) deleteParent(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	myerr := c.s.SetParent(ctx.Context(), id, nil)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete category parent error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -17,8 +17,20 @@ func New(f *fiber.App, l logger.Logger, services *service.Service) {
 	router := f.Group("/")
 
 	// init routes
-	newItemController(router, l, services.Item)
-	newCategoryController(router, l, services.Category)
-	newGroupController(router, l, services.Group)
-	newItemDetailController(router, l, services.ItemDetail)
+	newItemController(router, l, services.Item, services.Translation)
+	newCategoryController(router, l, services.Category, services.Translation)
+	newGroupController(router, l, services.Group, services.Translation)
+	newItemDetailController(router, l, services.ItemDetail, services.ExchangeRate, services.Promotion, services.Translation)
+	newExchangeRateController(router, l, services.ExchangeRate)
+	newTaxProfileController(router, l, services.TaxProfile)
+	newPromotionController(router, l, services.Promotion)
+	newModifierGroupController(router, l, services.ModifierGroup)
+	newBundleController(router, l, services.Bundle, services.Translation)
+	newTranslationController(router, l, services.Translation)
+	newTagController(router, l, services.Tag)
+	newImageController(router, l, services.Image)
+	newStockController(router, l, services.Stock)
+	newImportController(router, l, services.Import)
+	newExportController(router, l, services.Export, services.Translation)
+	newDocsController(router, l)
 }
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/logger"
+)
+
+//go:embed docs.html
+var _docsPage []byte
+
+type docsController struct {
+	l        logger.Logger
+	document []byte
+}
+
+func newDocsController(router fiber.Router, l logger.Logger) {
+	document, err := json.Marshal(openAPIDocument(_apiOperations))
+	if err != nil {
+		// the document is built from static types, so it can not fail at runtime
+		panic(fmt.Sprintf("marshal openapi document error: %s", err))
+	}
+
+	c := &docsController{
+		l:        l,
+		document: document,
+	}
+
+	router.Get("/openapi.json", c.openAPI)
+	router.Get("/docs", c.docs)
+}
+
+func (c *docsController) openAPI(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return ctx.Status(fiber.StatusOK).Send(c.document)
+}
+
+// docs serves the page rendering /openapi.json, it has no external dependencies.
+func (c *docsController) docs(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return ctx.Status(fiber.StatusOK).Send(_docsPage)
+}
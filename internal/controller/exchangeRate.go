@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type exchangeRateController struct {
+	s service.ExchangeRate
+	l logger.Logger
+}
+
+func newExchangeRateController(router fiber.Router, l logger.Logger, exchangeRateService service.ExchangeRate) {
+	c := &exchangeRateController{
+		s: exchangeRateService,
+		l: l,
+	}
+
+	r := router.Group("/exchange-rate")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+}
+
+// exchangeRateRequest is the exchange rate of create and update,
+// rate is the price in quote currency of one unit of base currency.
+type exchangeRateRequest struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+}
+
+func (r exchangeRateRequest) exchangeRate() *model.ExchangeRate {
+	return &model.ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate,
+	}
+}
+
+func (c *exchangeRateController) create(ctx *fiber.Ctx) error {
+	var req exchangeRateRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.exchangeRate())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create exchange rate error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *exchangeRateController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get exchange rate id param error")
+		return errorResponse(ctx, 400, "get exchange rate id param error")
+	}
+
+	rate, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get exchange rate error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(rate)
+}
+
+func (c *exchangeRateController) getAll(ctx *fiber.Ctx) error {
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	rates, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all exchange rates error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"exchange_rates": rates,
+		"next_cursor":    pageInfo.NextCursor,
+		"total":          pageInfo.Total,
+	})
+}
+
+func (c *exchangeRateController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get exchange rate id param error")
+		return errorResponse(ctx, 400, "get exchange rate id param error")
+	}
+
+	var req exchangeRateRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.exchangeRate())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update exchange rate error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *exchangeRateController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get exchange rate id param error")
+		return errorResponse(ctx, 400, "get exchange rate id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete exchange rate error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
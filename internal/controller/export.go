@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/export"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type exportController struct {
+	s           service.Export
+	translation service.Translation
+	l           logger.Logger
+}
+
+func newExportController(router fiber.Router, l logger.Logger, exportService service.Export, translationService service.Translation) {
+	c := &exportController{
+		s:           exportService,
+		translation: translationService,
+		l:           l,
+	}
+
+	r := router.Group("/export")
+
+	r.Get("/item-details", c.itemDetails)
+}
+
+// item detail export columns, in the default order
+var (
+	_itemDetailExportColumnNames = []string{
+		"id", "item_id", "item_name", "variant_name", "sku", "barcode", "category_id", "category_name", "group_id", "group_name", "tags",
+		"cost", "price", "margin", "margin_percent", "markup_percent", "currency",
+		"net_price", "service_charge", "vat", "tax_amount", "gross_price", "sort", "created_at", "updated_at",
+	}
+	_itemDetailExportColumns = map[string]func(v *model.ItemDetailView) interface{}{
+		"id":             func(v *model.ItemDetailView) interface{} { return v.ID },
+		"item_id":        func(v *model.ItemDetailView) interface{} { return v.ItemID },
+		"item_name":      func(v *model.ItemDetailView) interface{} { return v.ItemName },
+		"variant_name":   func(v *model.ItemDetailView) interface{} { return v.VariantName },
+		"sku":            func(v *model.ItemDetailView) interface{} { return v.SKU },
+		"barcode":        func(v *model.ItemDetailView) interface{} { return v.Barcode },
+		"category_id":    func(v *model.ItemDetailView) interface{} { return v.CategoryID },
+		"category_name":  func(v *model.ItemDetailView) interface{} { return v.CategoryName },
+		"group_id":       func(v *model.ItemDetailView) interface{} { return v.GroupID },
+		"group_name":     func(v *model.ItemDetailView) interface{} { return v.GroupName },
+		"tags":           func(v *model.ItemDetailView) interface{} { return strings.Join(v.Tags, ",") },
+		"cost":           func(v *model.ItemDetailView) interface{} { return v.Cost },
+		"price":          func(v *model.ItemDetailView) interface{} { return v.Price },
+		"margin":         func(v *model.ItemDetailView) interface{} { return v.Margin },
+		"margin_percent": func(v *model.ItemDetailView) interface{} { return v.MarginPercent },
+		"markup_percent": func(v *model.ItemDetailView) interface{} { return v.MarkupPercent },
+		"currency":       func(v *model.ItemDetailView) interface{} { return v.Currency },
+		"net_price":      func(v *model.ItemDetailView) interface{} { return v.NetPrice },
+		"service_charge": func(v *model.ItemDetailView) interface{} { return v.ServiceCharge },
+		"vat":            func(v *model.ItemDetailView) interface{} { return v.VAT },
+		"tax_amount":     func(v *model.ItemDetailView) interface{} { return v.TaxAmount },
+		"gross_price":    func(v *model.ItemDetailView) interface{} { return v.GrossPrice },
+		"sort":           func(v *model.ItemDetailView) interface{} { return v.Sort },
+		"created_at":     func(v *model.ItemDetailView) interface{} { return v.CreatedAt },
+		"updated_at":     func(v *model.ItemDetailView) interface{} { return v.UpdatedAt },
+	}
+)
+
+// the export is streamed after the handler returns, so it is bounded by its own timeout
+const _exportTimeout = 10 * time.Minute
+
+type exportParams struct {
+	Format  string `query:"format"`  // csv (default) or xlsx
+	Columns string `query:"columns"` // comma separated column names, all columns by default
+}
+
+// itemDetails streams item detail list by the same filters as GET /item-detail.
+func (c *exportController) itemDetails(ctx *fiber.Ctx) error {
+	var (
+		params       exportParams
+		filterParams itemDetailFilterParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&filterParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	filter, errMsg := filterParams.filter()
+	if errMsg != "" {
+		c.l.Error(errMsg)
+		return errorResponse(ctx, 400, errMsg)
+	}
+
+	format := strings.ToLower(params.Format)
+	if format == "" {
+		format = export.FormatCSV
+	}
+	if format != export.FormatCSV && format != export.FormatXLSX {
+		c.l.Error(fmt.Sprintf("invalid export format %s", format))
+		return errorResponse(ctx, 400, "invalid export format")
+	}
+
+	columns := _itemDetailExportColumnNames
+	if params.Columns != "" {
+		columns = strings.Split(params.Columns, ",")
+		for i, column := range columns {
+			columns[i] = strings.Clone(strings.TrimSpace(column))
+			if _, ok := _itemDetailExportColumns[columns[i]]; !ok {
+				c.l.Error(fmt.Sprintf("invalid export column %s", columns[i]))
+				return errorResponse(ctx, 400, fmt.Sprintf("invalid export column %s", columns[i]))
+			}
+		}
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	// ctx is released once the handler returns, so the stream writer may only use
+	// the detached context and the values captured here
+	exportCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx.UserContext()), _exportTimeout)
+	locale, format = strings.Clone(locale), strings.Clone(format)
+	filter = detachItemDetailFilter(filter)
+
+	stream, myerr := c.s.ItemDetails(exportCtx, filter, locale)
+	if myerr.IsErr() {
+		cancel()
+		c.l.Error(myerr.Err, "export item details error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	ctx.Set(fiber.HeaderContentType, export.ContentType(format))
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="item-details.%s"`, format))
+
+	// the body is written after the handler returns, errors can only be logged
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		if err := writeItemDetails(w, format, columns, stream); err != nil {
+			c.l.Error(err, "export item details error")
+		}
+	})
+
+	return nil
+}
+
+// detachItemDetailFilter copies the filter strings, which point to the request buffer
+// that is reused once the handler returns.
+func detachItemDetailFilter(filter *model.ItemDetailFilter) *model.ItemDetailFilter {
+	clone := func(s *string) *string {
+		if s == nil {
+			return nil
+		}
+		v := strings.Clone(*s)
+		return &v
+	}
+
+	detached := *filter
+	detached.Q = clone(filter.Q)
+	detached.ItemName = clone(filter.ItemName)
+	detached.CategoryName = clone(filter.CategoryName)
+	detached.GroupName = clone(filter.GroupName)
+	detached.TagsMode = strings.Clone(filter.TagsMode)
+	detached.Tags = make([]string, len(filter.Tags))
+	for i, tag := range filter.Tags {
+		detached.Tags[i] = strings.Clone(tag)
+	}
+	detached.OrderBy = make([]model.Order, len(filter.OrderBy))
+	for i, order := range filter.OrderBy {
+		detached.OrderBy[i] = model.Order{Field: strings.Clone(order.Field), Desc: order.Desc}
+	}
+
+	return &detached
+}
+
+func writeItemDetails(
+	w *bufio.Writer,
+	format string,
+	columns []string,
+	stream func(fn func(*model.ItemDetailView) error) error,
+) error {
+	ew, err := export.New(format, w)
+	if err != nil {
+		return err
+	}
+
+	header := make([]interface{}, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column)
+	}
+	if err := ew.Write(header); err != nil {
+		return err
+	}
+
+	row := make([]interface{}, len(columns))
+	err = stream(func(v *model.ItemDetailView) error {
+		for i, column := range columns {
+			row[i] = _itemDetailExportColumns[column](v)
+		}
+		return ew.Write(row)
+	})
+	if err != nil {
+		return err
+	}
+
+	return ew.Close()
+}
@@ -7,14 +7,16 @@ import (
 )
 
 type groupController struct {
-	s service.Group
-	l logger.Logger
+	s           service.Group
+	translation service.Translation
+	l           logger.Logger
 }
 
-func newGroupController(router fiber.Router, l logger.Logger, groupService service.Group) {
+func newGroupController(router fiber.Router, l logger.Logger, groupService service.Group, translationService service.Translation) {
 	c := &groupController{
-		s: groupService,
-		l: l,
+		s:           groupService,
+		translation: translationService,
+		l:           l,
 	}
 
 	r := router.Group("/group")
@@ -23,7 +25,10 @@ func newGroupController(router fiber.Router, l logger.Logger, groupService servi
 	r.Get("/:id", c.get)
 	r.Get("/", c.getAll)
 	r.Put("/:id", c.update)
+	r.Patch("/:id", c.patch)
 	r.Delete("/:id", c.delete)
+	r.Put("/:id/tax-profile", c.setTaxProfile)
+	r.Delete("/:id/tax-profile", c.deleteTaxProfile)
 }
 
 type groupCreateRequest struct {
@@ -56,24 +61,55 @@ func (c *groupController) get(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, 400, "get group id param error")
 	}
 
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	group, myerr := c.s.Get(ctx.Context(), id)
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get group error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeGroups(ctx.Context(), locale, group); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(group)
 }
 
 func (c *groupController) getAll(ctx *fiber.Ctx) error {
-	groups, myerr := c.s.GetAll(ctx.Context())
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	groups, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get all groups error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeGroups(ctx.Context(), locale, groups...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize group list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"groups": groups,
+		"groups":      groups,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
 	})
 }
 
@@ -104,6 +140,39 @@ func (c *groupController) update(ctx *fiber.Ctx) error {
 	return ctx.SendStatus(fiber.StatusOK)
 }
 
+type groupPatchRequest struct {
+	GroupName patchField[string] `json:"group_name"`
+}
+
+func (c *groupController) patch(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get group id param error")
+		return errorResponse(ctx, 400, "get group id param error")
+	}
+
+	var req groupPatchRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	name, err := req.GroupName.get("group_name")
+	if err != nil {
+		c.l.Error(err, "patch group error")
+		return errorResponse(ctx, 400, err.Error())
+	}
+
+	myerr := c.s.Patch(ctx.Context(), id, name)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
 func (c *groupController) delete(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -119,3 +188,51 @@ func (c *groupController) delete(ctx *fiber.Ctx) error {
 
 	return ctx.SendStatus(fiber.StatusOK)
 }
+
+// groupTaxProfileRequest attaches the tax profile to the group.
+type groupTaxProfileRequest struct {
+	TaxProfileID *int `json:"tax_profile_id"`
+}
+
+func (c *groupController) setTaxProfile(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get group id param error")
+		return errorResponse(ctx, 400, "get group id param error")
+	}
+
+	var req groupTaxProfileRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+	if req.TaxProfileID == nil {
+		c.l.Error("tax_profile_id is missing")
+		return errorResponse(ctx, 400, "tax_profile_id is required")
+	}
+
+	myerr := c.s.SetTaxProfile(ctx.Context(), id, req.TaxProfileID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "set group tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *groupController) deleteTaxProfile(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get group id param error")
+		return errorResponse(ctx, 400, "get group id param error")
+	}
+
+	myerr := c.s.SetTaxProfile(ctx.Context(), id, nil)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete group tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
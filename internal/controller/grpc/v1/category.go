@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type categoryServer struct {
+	pb.UnimplementedCategoryServiceServer
+
+	s             service.Category
+	modifierGroup service.ModifierGroup
+	l             logger.Logger
+}
+
+func newCategoryServer(l logger.Logger, categoryService service.Category, modifierGroupService service.ModifierGroup) *categoryServer {
+	return &categoryServer{
+		s:             categoryService,
+		modifierGroup: modifierGroupService,
+		l:             l,
+	}
+}
+
+func (c *categoryServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateResponse, error) {
+	id, myerr := c.s.Create(ctx, req.GetCategoryName())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create category error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateResponse{Id: int64(id)}, nil
+}
+
+func (c *categoryServer) GetCategory(ctx context.Context, req *pb.IDRequest) (*pb.Category, error) {
+	category, myerr := c.s.Get(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get category error")
+		return nil, statusError(myerr)
+	}
+
+	return categoryMessage(category), nil
+}
+
+func (c *categoryServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categories, info, myerr := c.s.GetAll(ctx, page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get category list error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListCategoriesResponse{
+		Categories: make([]*pb.Category, 0, len(categories)),
+		PageInfo:   pageInfo(info),
+	}
+	for _, category := range categories {
+		res.Categories = append(res.Categories, categoryMessage(category))
+	}
+
+	return res, nil
+}
+
+func (c *categoryServer) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Update(ctx, int(req.GetId()), req.GetCategoryName()); myerr.IsErr() {
+		c.l.Error(myerr.Err, "update category error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *categoryServer) PatchCategory(ctx context.Context, req *pb.PatchCategoryRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Patch(ctx, int(req.GetId()), req.CategoryName); myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch category error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *categoryServer) DeleteCategory(ctx context.Context, req *pb.IDRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Delete(ctx, int(req.GetId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete category error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func categoryMessage(category *model.Category) *pb.Category {
+	return &pb.Category{
+		Id:           int64(category.ID),
+		CategoryName: category.CategoryName,
+		CreatedAt:    timestamp(&category.CreatedAt),
+		UpdatedAt:    timestamp(&category.UpdatedAt),
+		DeletedAt:    timestamp(category.DeletedAt),
+		TaxProfileId: int64Ptr(category.TaxProfileID),
+		ParentId:     int64Ptr(category.ParentID),
+	}
+}
+
+func categoryNodeMessages(nodes []*model.CategoryNode) []*pb.CategoryNode {
+	messages := make([]*pb.CategoryNode, 0, len(nodes))
+	for _, node := range nodes {
+		messages = append(messages, &pb.CategoryNode{
+			Category: categoryMessage(node.Category),
+			Children: categoryNodeMessages(node.Children),
+		})
+	}
+
+	return messages
+}
+
+func (c *categoryServer) GetCategoryTree(ctx context.Context, _ *emptypb.Empty) (*pb.CategoryTreeResponse, error) {
+	tree, myerr := c.s.GetTree(ctx)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get category tree error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CategoryTreeResponse{Categories: categoryNodeMessages(tree)}, nil
+}
+
+func (c *categoryServer) SetCategoryParent(ctx context.Context, req *pb.SetCategoryParentRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.SetParent(ctx, int(req.GetId()), intPtr(req.ParentId)); myerr.IsErr() {
+		c.l.Error(myerr.Err, "set category parent error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *categoryServer) SetCategoryTaxProfile(ctx context.Context, req *pb.SetTaxProfileRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.SetTaxProfile(ctx, int(req.GetId()), intPtr(req.TaxProfileId)); myerr.IsErr() {
+		c.l.Error(myerr.Err, "set category tax profile error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *categoryServer) AttachCategoryModifierGroup(ctx context.Context, req *pb.ModifierGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.modifierGroup.AttachToCategory(ctx, int(req.GetId()), int(req.GetModifierGroupId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "attach modifier group to category error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *categoryServer) DetachCategoryModifierGroup(ctx context.Context, req *pb.ModifierGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.modifierGroup.DetachFromCategory(ctx, int(req.GetId()), int(req.GetModifierGroupId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "detach modifier group from category error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type groupServer struct {
+	pb.UnimplementedGroupServiceServer
+
+	s service.Group
+	l logger.Logger
+}
+
+func newGroupServer(l logger.Logger, groupService service.Group) *groupServer {
+	return &groupServer{
+		s: groupService,
+		l: l,
+	}
+}
+
+func (c *groupServer) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.CreateResponse, error) {
+	id, myerr := c.s.Create(ctx, req.GetGroupName())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create group error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateResponse{Id: int64(id)}, nil
+}
+
+func (c *groupServer) GetGroup(ctx context.Context, req *pb.IDRequest) (*pb.Group, error) {
+	group, myerr := c.s.Get(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get group error")
+		return nil, statusError(myerr)
+	}
+
+	return groupMessage(group), nil
+}
+
+func (c *groupServer) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	groups, info, myerr := c.s.GetAll(ctx, page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get group list error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListGroupsResponse{
+		Groups:   make([]*pb.Group, 0, len(groups)),
+		PageInfo: pageInfo(info),
+	}
+	for _, group := range groups {
+		res.Groups = append(res.Groups, groupMessage(group))
+	}
+
+	return res, nil
+}
+
+func (c *groupServer) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Update(ctx, int(req.GetId()), req.GetGroupName()); myerr.IsErr() {
+		c.l.Error(myerr.Err, "update group error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *groupServer) PatchGroup(ctx context.Context, req *pb.PatchGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Patch(ctx, int(req.GetId()), req.GroupName); myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch group error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *groupServer) DeleteGroup(ctx context.Context, req *pb.IDRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Delete(ctx, int(req.GetId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete group error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func groupMessage(group *model.Group) *pb.Group {
+	return &pb.Group{
+		Id:           int64(group.ID),
+		GroupName:    group.GroupName,
+		CreatedAt:    timestamp(&group.CreatedAt),
+		UpdatedAt:    timestamp(&group.UpdatedAt),
+		DeletedAt:    timestamp(group.DeletedAt),
+		TaxProfileId: int64Ptr(group.TaxProfileID),
+	}
+}
+
+func (c *groupServer) SetGroupTaxProfile(ctx context.Context, req *pb.SetTaxProfileRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.SetTaxProfile(ctx, int(req.GetId()), intPtr(req.TaxProfileId)); myerr.IsErr() {
+		c.l.Error(myerr.Err, "set group tax profile error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
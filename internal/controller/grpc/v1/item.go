@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type itemServer struct {
+	pb.UnimplementedItemServiceServer
+
+	s   service.Item
+	tag service.Tag
+	l   logger.Logger
+}
+
+func newItemServer(l logger.Logger, itemService service.Item, tagService service.Tag) *itemServer {
+	return &itemServer{
+		s:   itemService,
+		tag: tagService,
+		l:   l,
+	}
+}
+
+func (c *itemServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.CreateResponse, error) {
+	id, myerr := c.s.Create(ctx, req.GetItemName())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create item error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateResponse{Id: int64(id)}, nil
+}
+
+func (c *itemServer) GetItem(ctx context.Context, req *pb.IDRequest) (*pb.Item, error) {
+	item, myerr := c.s.Get(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item error")
+		return nil, statusError(myerr)
+	}
+
+	return itemMessage(item), nil
+}
+
+func (c *itemServer) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	filter := &model.ItemFilter{
+		Q:        searchQuery(req.Q),
+		Tags:     req.GetTags(),
+		TagsMode: req.GetTagsMode(),
+	}
+
+	items, info, myerr := c.s.GetAll(ctx, filter, page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item list error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListItemsResponse{
+		Items:    make([]*pb.Item, 0, len(items)),
+		PageInfo: pageInfo(info),
+	}
+	for _, item := range items {
+		res.Items = append(res.Items, itemMessage(item))
+	}
+
+	return res, nil
+}
+
+func (c *itemServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Update(ctx, int(req.GetId()), req.GetItemName()); myerr.IsErr() {
+		c.l.Error(myerr.Err, "update item error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemServer) PatchItem(ctx context.Context, req *pb.PatchItemRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Patch(ctx, int(req.GetId()), req.ItemName); myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch item error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemServer) DeleteItem(ctx context.Context, req *pb.IDRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Delete(ctx, int(req.GetId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete item error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemServer) AddItemTag(ctx context.Context, req *pb.ItemTagRequest) (*emptypb.Empty, error) {
+	if myerr := c.tag.AttachToItem(ctx, int(req.GetId()), int(req.GetTagId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "add tag to item error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemServer) RemoveItemTag(ctx context.Context, req *pb.ItemTagRequest) (*emptypb.Empty, error) {
+	if myerr := c.tag.DetachFromItem(ctx, int(req.GetId()), int(req.GetTagId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "remove tag from item error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func itemMessage(item *model.Item) *pb.Item {
+	return &pb.Item{
+		Id:        int64(item.ID),
+		ItemName:  item.ItemName,
+		CreatedAt: timestamp(&item.CreatedAt),
+		UpdatedAt: timestamp(&item.UpdatedAt),
+		DeletedAt: timestamp(item.DeletedAt),
+		Relevance: item.Relevance,
+		Tags:      item.Tags,
+		Images:    imageLinkMessages(item.Images),
+	}
+}
+
+func imageLinkMessages(links []*model.ImageLink) []*pb.ImageLink {
+	res := make([]*pb.ImageLink, 0, len(links))
+	for _, link := range links {
+		res = append(res, &pb.ImageLink{
+			Id:           int64(link.ID),
+			Url:          link.URL,
+			ThumbnailUrl: link.ThumbnailURL,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,489 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type itemDetailServer struct {
+	pb.UnimplementedItemDetailServiceServer
+
+	s             service.ItemDetail
+	promotion     service.Promotion
+	modifierGroup service.ModifierGroup
+	stock         service.Stock
+	l             logger.Logger
+}
+
+func newItemDetailServer(
+	l logger.Logger,
+	itemDetailService service.ItemDetail,
+	promotionService service.Promotion,
+	modifierGroupService service.ModifierGroup,
+	stockService service.Stock,
+) *itemDetailServer {
+	return &itemDetailServer{
+		s:             itemDetailService,
+		promotion:     promotionService,
+		modifierGroup: modifierGroupService,
+		stock:         stockService,
+		l:             l,
+	}
+}
+
+func (c *itemDetailServer) CreateItemDetail(ctx context.Context, req *pb.CreateItemDetailRequest) (*pb.CreateResponse, error) {
+	itemDetail, itemName := itemDetailInput(req.GetItemDetail())
+
+	id, myerr := c.s.Create(ctx, itemDetail, itemName)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateResponse{Id: int64(id)}, nil
+}
+
+func (c *itemDetailServer) GetItemDetail(ctx context.Context, req *pb.IDRequest) (*pb.ItemDetailView, error) {
+	itemDetail, myerr := c.s.Get(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return itemDetailViewMessage(itemDetail), nil
+}
+
+func (c *itemDetailServer) GetItemDetailByBarcode(ctx context.Context, req *pb.GetItemDetailByBarcodeRequest) (*pb.ItemDetailView, error) {
+	itemDetail, myerr := c.s.GetByBarcode(ctx, req.GetBarcode())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail by barcode error")
+		return nil, statusError(myerr)
+	}
+
+	return itemDetailViewMessage(itemDetail), nil
+}
+
+// itemDetailFilter converts item detail list filter, missing filter is the empty filter.
+func itemDetailFilter(f *pb.ItemDetailFilter) *model.ItemDetailFilter {
+	filter := &model.ItemDetailFilter{}
+	if f == nil {
+		return filter
+	}
+
+	filter.Q = searchQuery(f.Q)
+	filter.ItemName = f.ItemName
+	filter.CategoryName = f.CategoryName
+	filter.IncludeSubcategories = f.GetIncludeSubcategories()
+	filter.GroupName = f.GroupName
+	filter.Tags = f.GetTags()
+	filter.TagsMode = f.GetTagsMode()
+	filter.MinMarginPercent = decimalPtr(f.MinMarginPercent)
+	filter.MaxMarginPercent = decimalPtr(f.MaxMarginPercent)
+	if f.GetId() > 0 {
+		filter.ID = intPtr(f.Id)
+	}
+	if f.GetCategoryId() > 0 {
+		filter.CategoryID = intPtr(f.CategoryId)
+	}
+	for _, order := range f.GetOrderBy() {
+		filter.OrderBy = append(filter.OrderBy, model.Order{Field: order.GetField(), Desc: order.GetDesc()})
+	}
+
+	return filter
+}
+
+func (c *itemDetailServer) ListItemDetails(ctx context.Context, req *pb.ListItemDetailsRequest) (*pb.ListItemDetailsResponse, error) {
+	itemDetails, info, myerr := c.s.GetAllFilter(ctx, itemDetailFilter(req.GetFilter()), page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail list error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListItemDetailsResponse{
+		ItemDetails: make([]*pb.ItemDetailView, 0, len(itemDetails)),
+		PageInfo:    pageInfo(info),
+	}
+	for _, itemDetail := range itemDetails {
+		res.ItemDetails = append(res.ItemDetails, itemDetailViewMessage(itemDetail))
+	}
+
+	return res, nil
+}
+
+func (c *itemDetailServer) ListItemDetailsGrouped(ctx context.Context, req *pb.ListItemDetailsRequest) (*pb.ListItemDetailsGroupedResponse, error) {
+	items, info, myerr := c.s.GetAllGrouped(ctx, itemDetailFilter(req.GetFilter()), page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get grouped item detail list error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListItemDetailsGroupedResponse{
+		Items:    make([]*pb.ItemVariants, 0, len(items)),
+		PageInfo: pageInfo(info),
+	}
+	for _, item := range items {
+		variants := make([]*pb.ItemDetailView, 0, len(item.Variants))
+		for _, itemDetail := range item.Variants {
+			variants = append(variants, itemDetailViewMessage(itemDetail))
+		}
+		res.Items = append(res.Items, &pb.ItemVariants{
+			ItemId:   int64(item.ItemID),
+			ItemName: item.ItemName,
+			Variants: variants,
+		})
+	}
+
+	return res, nil
+}
+
+func (c *itemDetailServer) UpdateItemDetail(ctx context.Context, req *pb.UpdateItemDetailRequest) (*emptypb.Empty, error) {
+	itemDetail, itemName := itemDetailInput(req.GetItemDetail())
+
+	if myerr := c.s.Update(ctx, int(req.GetId()), itemName, itemDetail); myerr.IsErr() {
+		c.l.Error(myerr.Err, "update item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemDetailServer) PatchItemDetail(ctx context.Context, req *pb.PatchItemDetailRequest) (*emptypb.Empty, error) {
+	patch := &model.ItemDetailPatch{
+		ItemName:    req.ItemName,
+		VariantName: req.VariantName,
+		SKU:         req.Sku,
+		Barcode:     req.Barcode,
+		GroupID:     intPtr(req.GroupId),
+		CategoryID:  intPtr(req.CategoryId),
+		Cost:        req.Cost,
+		Price:       req.Price,
+		Currency:    req.Currency,
+	}
+	if req.Sort != nil {
+		sort := int(*req.Sort)
+		patch.Sort = &sort
+	}
+
+	if myerr := c.s.Patch(ctx, int(req.GetId()), patch); myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemDetailServer) DeleteItemDetail(ctx context.Context, req *pb.IDRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.Delete(ctx, int(req.GetId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemDetailServer) BulkItemDetails(ctx context.Context, req *pb.BulkItemDetailsRequest) (*pb.BulkItemDetailsResponse, error) {
+	ops := make([]*model.ItemDetailBulkOp, 0, len(req.GetOperations()))
+	for _, op := range req.GetOperations() {
+		itemDetail, itemName := itemDetailInput(op.GetItemDetail())
+		ops = append(ops, &model.ItemDetailBulkOp{
+			Op:         op.GetOp(),
+			ID:         int(op.GetId()),
+			ItemName:   itemName,
+			ItemDetail: itemDetail,
+		})
+	}
+
+	results, myerr := c.s.Bulk(ctx, req.GetMode(), ops)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "bulk item detail error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.BulkItemDetailsResponse{
+		Results: make([]*pb.ItemDetailBulkResult, 0, len(results)),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case model.BulkStatusApplied:
+			res.Applied++
+		case model.BulkStatusFailed:
+			res.Failed++
+		}
+		res.Results = append(res.Results, &pb.ItemDetailBulkResult{
+			Index:  int32(result.Index),
+			Op:     result.Op,
+			Id:     int64(result.ID),
+			Status: result.Status,
+			Code:   int32(result.Code),
+			Error:  result.Error,
+		})
+	}
+
+	return res, nil
+}
+
+// itemDetailInput converts item detail of create and update, missing input is the zero item detail.
+func itemDetailInput(input *pb.ItemDetailInput) (*model.ItemDetail, string) {
+	var variantName *string
+	if input.GetVariantName() != "" {
+		name := input.GetVariantName()
+		variantName = &name
+	}
+	// empty sku and barcode are dropped by the service
+	sku, barcode := input.GetSku(), input.GetBarcode()
+
+	return &model.ItemDetail{
+		VariantName: variantName,
+		SKU:         &sku,
+		Barcode:     &barcode,
+		GroupID:     int(input.GetGroupId()),
+		CategoryID:  int(input.GetCategoryId()),
+		Cost:        input.GetCost(),
+		Price:       input.GetPrice(),
+		Currency:    input.GetCurrency(),
+		Sort:        int(input.GetSort()),
+	}, input.GetItemName()
+}
+
+func itemDetailViewMessage(v *model.ItemDetailView) *pb.ItemDetailView {
+	return &pb.ItemDetailView{
+		Id:             int64(v.ID),
+		ItemId:         int64(v.ItemID),
+		ItemName:       v.ItemName,
+		VariantName:    v.VariantName,
+		Sku:            v.SKU,
+		Barcode:        v.Barcode,
+		CategoryId:     int64(v.CategoryID),
+		CategoryName:   v.CategoryName,
+		GroupId:        int64(v.GroupID),
+		GroupName:      v.GroupName,
+		Tags:           v.Tags,
+		Images:         imageLinkMessages(v.Images),
+		Cost:           v.Cost.InexactFloat64(),
+		Price:          v.Price.InexactFloat64(),
+		Margin:         v.Margin.InexactFloat64(),
+		MarginPercent:  floatPtr(v.MarginPercent),
+		MarkupPercent:  floatPtr(v.MarkupPercent),
+		Currency:       v.Currency,
+		TaxProfile:     taxProfileMessage(v.TaxProfile),
+		NetPrice:       v.NetPrice.InexactFloat64(),
+		ServiceCharge:  v.ServiceCharge.InexactFloat64(),
+		Vat:            v.VAT.InexactFloat64(),
+		TaxAmount:      v.TaxAmount.InexactFloat64(),
+		GrossPrice:     v.GrossPrice.InexactFloat64(),
+		ModifierGroups: modifierGroupMessages(v.ModifierGroups),
+		Sort:           int32(v.Sort),
+		CreatedAt:      timestamp(&v.CreatedAt),
+		UpdatedAt:      timestamp(&v.UpdatedAt),
+		DeletedAt:      timestamp(v.DeletedAt),
+		Relevance:      v.Relevance,
+	}
+}
+
+func taxProfileMessage(profile *model.TaxProfile) *pb.TaxProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &pb.TaxProfile{
+		Id:                   int64(profile.ID),
+		ProfileName:          profile.ProfileName,
+		VatPercent:           profile.VATPercent.InexactFloat64(),
+		ServiceChargePercent: profile.ServiceChargePercent.InexactFloat64(),
+		PriceIncludesTax:     profile.PriceIncludesTax,
+	}
+}
+
+func modifierGroupMessages(groups []*model.ModifierGroup) []*pb.ModifierGroup {
+	res := make([]*pb.ModifierGroup, 0, len(groups))
+	for _, group := range groups {
+		modifiers := make([]*pb.Modifier, 0, len(group.Modifiers))
+		for _, modifier := range group.Modifiers {
+			modifiers = append(modifiers, &pb.Modifier{
+				Id:              int64(modifier.ID),
+				ModifierGroupId: int64(modifier.ModifierGroupID),
+				ModifierName:    modifier.ModifierName,
+				PriceDelta:      modifier.PriceDelta,
+				Sort:            int32(modifier.Sort),
+			})
+		}
+
+		res = append(res, &pb.ModifierGroup{
+			Id:                int64(group.ID),
+			ModifierGroupName: group.ModifierGroupName,
+			MinSelections:     int32(group.MinSelections),
+			MaxSelections:     int32(group.MaxSelections),
+			Modifiers:         modifiers,
+		})
+	}
+
+	return res
+}
+
+func (c *itemDetailServer) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	filter := &model.PriceHistoryFilter{}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	prices, myerr := c.s.PriceHistory(ctx, int(req.GetId()), filter)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail price history error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.GetPriceHistoryResponse{
+		Prices: make([]*pb.ItemDetailPrice, 0, len(prices)),
+	}
+	for _, price := range prices {
+		res.Prices = append(res.Prices, itemDetailPriceMessage(price))
+	}
+
+	return res, nil
+}
+
+func (c *itemDetailServer) GetPriceAt(ctx context.Context, req *pb.GetPriceAtRequest) (*pb.ItemDetailPrice, error) {
+	at := time.Now()
+	if req.At != nil {
+		at = req.At.AsTime()
+	}
+
+	price, myerr := c.s.PriceAt(ctx, int(req.GetId()), at)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail price error")
+		return nil, statusError(myerr)
+	}
+
+	return itemDetailPriceMessage(price), nil
+}
+
+func itemDetailPriceMessage(price *model.ItemDetailPrice) *pb.ItemDetailPrice {
+	return &pb.ItemDetailPrice{
+		ItemDetailId: int64(price.ItemDetailID),
+		Cost:         price.Cost,
+		Price:        price.Price,
+		Currency:     price.Currency,
+		ChangedAt:    timestamp(&price.ChangedAt),
+	}
+}
+
+func (c *itemDetailServer) GetPromotionalPrice(ctx context.Context, req *pb.GetPromotionalPriceRequest) (*pb.PromotionalPrice, error) {
+	quantity := 1
+	if req.Quantity != 0 {
+		quantity = int(req.GetQuantity())
+	}
+	at := time.Now()
+	if req.At != nil {
+		at = req.At.AsTime().Local()
+	}
+
+	price, myerr := c.promotion.PromotionalPrice(ctx, int(req.GetId()), quantity, at)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail promotional price error")
+		return nil, statusError(myerr)
+	}
+
+	appliedPromotions := make([]*pb.AppliedPromotion, 0, len(price.AppliedPromotions))
+	for _, promotion := range price.AppliedPromotions {
+		appliedPromotions = append(appliedPromotions, &pb.AppliedPromotion{
+			PromotionId:   int64(promotion.PromotionID),
+			PromotionName: promotion.PromotionName,
+			DiscountType:  promotion.DiscountType,
+			Discount:      promotion.Discount,
+		})
+	}
+
+	return &pb.PromotionalPrice{
+		ItemDetailId:      int64(price.ItemDetailID),
+		Quantity:          int64(price.Quantity),
+		Currency:          price.Currency,
+		At:                timestamp(&price.At),
+		Price:             price.Price,
+		Total:             price.Total,
+		Discount:          price.Discount,
+		PromotionalTotal:  price.PromotionalTotal,
+		PromotionalPrice:  price.PromotionalPrice,
+		AppliedPromotions: appliedPromotions,
+	}, nil
+}
+
+func (c *itemDetailServer) SchedulePrice(ctx context.Context, req *pb.SchedulePriceRequest) (*pb.CreateResponse, error) {
+	scheduledPrice := &model.ItemDetailScheduledPrice{
+		ItemDetailID: int(req.GetId()),
+		Cost:         req.GetCost(),
+		Price:        req.GetPrice(),
+	}
+	if req.EffectiveFrom != nil {
+		scheduledPrice.EffectiveFrom = req.EffectiveFrom.AsTime()
+	}
+
+	id, myerr := c.s.SchedulePrice(ctx, scheduledPrice)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "schedule item detail price error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateResponse{Id: int64(id)}, nil
+}
+
+func (c *itemDetailServer) ListScheduledPrices(ctx context.Context, req *pb.IDRequest) (*pb.ListScheduledPricesResponse, error) {
+	scheduledPrices, myerr := c.s.ScheduledPrices(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail scheduled prices error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListScheduledPricesResponse{
+		ScheduledPrices: make([]*pb.ItemDetailScheduledPrice, 0, len(scheduledPrices)),
+	}
+	for _, scheduledPrice := range scheduledPrices {
+		res.ScheduledPrices = append(res.ScheduledPrices, &pb.ItemDetailScheduledPrice{
+			Id:            int64(scheduledPrice.ID),
+			ItemDetailId:  int64(scheduledPrice.ItemDetailID),
+			Cost:          scheduledPrice.Cost,
+			Price:         scheduledPrice.Price,
+			EffectiveFrom: timestamp(&scheduledPrice.EffectiveFrom),
+			CreatedAt:     timestamp(&scheduledPrice.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (c *itemDetailServer) CancelScheduledPrice(ctx context.Context, req *pb.CancelScheduledPriceRequest) (*emptypb.Empty, error) {
+	if myerr := c.s.CancelScheduledPrice(ctx, int(req.GetId()), int(req.GetScheduledPriceId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "cancel item detail scheduled price error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemDetailServer) AttachModifierGroup(ctx context.Context, req *pb.ModifierGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.modifierGroup.AttachToItemDetail(ctx, int(req.GetId()), int(req.GetModifierGroupId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "attach modifier group to item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *itemDetailServer) DetachModifierGroup(ctx context.Context, req *pb.ModifierGroupRequest) (*emptypb.Empty, error) {
+	if myerr := c.modifierGroup.DetachFromItemDetail(ctx, int(req.GetId()), int(req.GetModifierGroupId())); myerr.IsErr() {
+		c.l.Error(myerr.Err, "detach modifier group from item detail error")
+		return nil, statusError(myerr)
+	}
+
+	return &emptypb.Empty{}, nil
+}
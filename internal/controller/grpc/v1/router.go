@@ -0,0 +1,144 @@
+// Package v1 implements catalog.v1 gRPC services over the service layer.
+package v1
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// New registers the gRPC services on the server.
+func New(s *grpc.Server, l logger.Logger, services *service.Service) {
+	pb.RegisterItemServiceServer(s, newItemServer(l, services.Item, services.Tag))
+	pb.RegisterCategoryServiceServer(s, newCategoryServer(l, services.Category, services.ModifierGroup))
+	pb.RegisterGroupServiceServer(s, newGroupServer(l, services.Group))
+	pb.RegisterItemDetailServiceServer(s, newItemDetailServer(l, services.ItemDetail, services.Promotion, services.ModifierGroup, services.Stock))
+}
+
+// statusError converts the service error to gRPC status error with the same message as the REST API.
+func statusError(myerr errs.Error) error {
+	return status.Error(statusCode(myerr.Code), myerr.Message)
+}
+
+// statusCode maps HTTP status code of the service error to gRPC code,
+// other client errors are failed preconditions and other server errors are internal.
+func statusCode(code int) codes.Code {
+	switch code {
+	case 400, 422:
+		return codes.InvalidArgument
+	case 401:
+		return codes.Unauthenticated
+	case 403:
+		return codes.PermissionDenied
+	case 404:
+		return codes.NotFound
+	case 409:
+		return codes.AlreadyExists
+	case 429:
+		return codes.ResourceExhausted
+	case 499:
+		return codes.Canceled
+	case 500:
+		return codes.Internal
+	case 501:
+		return codes.Unimplemented
+	case 503:
+		return codes.Unavailable
+	case 504:
+		return codes.DeadlineExceeded
+	}
+
+	switch {
+	case code >= 400 && code < 500:
+		return codes.FailedPrecondition
+	case code >= 500 && code < 600:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+func page(p *pb.Page) *model.Page {
+	return &model.Page{
+		Limit:  int(p.GetLimit()),
+		Cursor: p.GetCursor(),
+	}
+}
+
+func pageInfo(p *model.PageInfo) *pb.PageInfo {
+	return &pb.PageInfo{
+		NextCursor: p.NextCursor,
+		Total:      int32(p.Total),
+	}
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// intPtr converts optional int64 field of the request.
+func intPtr(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+
+	return &i
+}
+
+// int64Ptr converts optional int field of the response.
+func int64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+
+	return &i
+}
+
+// searchQuery returns q search param, nil if it is blank.
+func searchQuery(q *string) *string {
+	if q == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*q)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
+
+// decimalPtr converts optional double field of the request.
+func decimalPtr(v *float64) *decimal.Decimal {
+	if v == nil {
+		return nil
+	}
+
+	d := decimal.NewFromFloat(*v)
+	return &d
+}
+
+// floatPtr converts optional decimal to optional double field of the response.
+func floatPtr(d *decimal.Decimal) *float64 {
+	if d == nil {
+		return nil
+	}
+
+	v := d.InexactFloat64()
+	return &v
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		code int
+		want codes.Code
+	}{
+		{400, codes.InvalidArgument},
+		{401, codes.Unauthenticated},
+		{403, codes.PermissionDenied},
+		{404, codes.NotFound},
+		{409, codes.AlreadyExists},
+		{412, codes.FailedPrecondition},
+		{413, codes.FailedPrecondition},
+		{422, codes.InvalidArgument},
+		{429, codes.ResourceExhausted},
+		{499, codes.Canceled},
+		{500, codes.Internal},
+		{501, codes.Unimplemented},
+		{502, codes.Internal},
+		{503, codes.Unavailable},
+		{504, codes.DeadlineExceeded},
+		{0, codes.Unknown},
+		{200, codes.Unknown},
+		{302, codes.Unknown},
+		{600, codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		if got := statusCode(tt.code); got != tt.want {
+			t.Errorf("statusCode(%d) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	err := statusError(errs.Error{
+		Err:     errors.New("get item error: not found"),
+		Code:    404,
+		Message: errs.StatusNotFoundMessage,
+	})
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("statusError returned %T, want gRPC status error", err)
+	}
+	// the message of the REST API is returned, not the internal error
+	if st.Code() != codes.NotFound || st.Message() != errs.StatusNotFoundMessage {
+		t.Errorf("status = %v %q, want %v %q", st.Code(), st.Message(), codes.NotFound, errs.StatusNotFoundMessage)
+	}
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/lmnq/test-thai/internal/model"
+	pb "github.com/lmnq/test-thai/pkg/pb/catalog/v1"
+)
+
+func (c *itemDetailServer) CreateStockMovement(ctx context.Context, req *pb.CreateStockMovementRequest) (*pb.CreateStockMovementResponse, error) {
+	movement, myerr := c.stock.CreateMovement(ctx, &model.StockMovement{
+		ItemDetailID: int(req.GetItemDetailId()),
+		Type:         req.GetType(),
+		Quantity:     req.GetQuantity(),
+		Note:         req.GetNote(),
+	})
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create stock movement error")
+		return nil, statusError(myerr)
+	}
+
+	return &pb.CreateStockMovementResponse{
+		Id:           int64(movement.ID),
+		BalanceAfter: movement.BalanceAfter,
+	}, nil
+}
+
+func (c *itemDetailServer) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	movements, info, myerr := c.stock.Movements(ctx, int(req.GetItemDetailId()), page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock movements error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListStockMovementsResponse{
+		Movements: make([]*pb.StockMovement, 0, len(movements)),
+		PageInfo:  pageInfo(info),
+	}
+	for _, movement := range movements {
+		res.Movements = append(res.Movements, &pb.StockMovement{
+			Id:           int64(movement.ID),
+			ItemDetailId: int64(movement.ItemDetailID),
+			Type:         movement.Type,
+			Quantity:     movement.Quantity,
+			BalanceAfter: movement.BalanceAfter,
+			Note:         movement.Note,
+			CreatedAt:    timestamp(&movement.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (c *itemDetailServer) GetStockBalance(ctx context.Context, req *pb.IDRequest) (*pb.StockBalance, error) {
+	balance, myerr := c.stock.Balance(ctx, int(req.GetId()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock balance error")
+		return nil, statusError(myerr)
+	}
+
+	return stockBalanceMessage(balance), nil
+}
+
+func (c *itemDetailServer) ListStockBalances(ctx context.Context, req *pb.ListStockBalancesRequest) (*pb.ListStockBalancesResponse, error) {
+	balances, info, myerr := c.stock.Balances(ctx, page(req.GetPage()))
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock balances error")
+		return nil, statusError(myerr)
+	}
+
+	res := &pb.ListStockBalancesResponse{
+		Balances: make([]*pb.StockBalance, 0, len(balances)),
+		PageInfo: pageInfo(info),
+	}
+	for _, balance := range balances {
+		res.Balances = append(res.Balances, stockBalanceMessage(balance))
+	}
+
+	return res, nil
+}
+
+func stockBalanceMessage(balance *model.StockBalance) *pb.StockBalance {
+	return &pb.StockBalance{
+		ItemDetailId: int64(balance.ItemDetailID),
+		Quantity:     balance.Quantity,
+		UpdatedAt:    timestamp(balance.UpdatedAt),
+	}
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type imageController struct {
+	s service.Image
+	l logger.Logger
+}
+
+func newImageController(router fiber.Router, l logger.Logger, imageService service.Image) {
+	c := &imageController{
+		s: imageService,
+		l: l,
+	}
+
+	r := router.Group("/item/:id/images")
+
+	r.Post("/", c.upload)
+	r.Get("/", c.getAll)
+	r.Get("/:imageId", c.download(false))
+	r.Get("/:imageId/thumbnail", c.download(true))
+	r.Delete("/:imageId", c.delete)
+}
+
+// images never change, a new upload gets a new id
+const _imageCacheControl = "public, max-age=31536000, immutable"
+
+// upload accepts the image as multipart "image" field or as the raw request body.
+func (c *imageController) upload(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+
+	var file io.Reader = bytes.NewReader(ctx.Body())
+	if fileHeader, err := ctx.FormFile("image"); err == nil {
+		f, err := fileHeader.Open()
+		if err != nil {
+			c.l.Error(err, "open image file error")
+			return errorResponse(ctx, 400, "open image file error")
+		}
+		defer f.Close()
+		file = f
+	}
+
+	imageID, myerr := c.s.Upload(ctx.Context(), id, file)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "upload item image error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": imageID,
+	})
+}
+
+func (c *imageController) getAll(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+
+	images, myerr := c.s.GetAll(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all item images error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"images": images,
+	})
+}
+
+// download streams the image or its thumbnail.
+func (c *imageController) download(thumbnail bool) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		id, err := ctx.ParamsInt("id")
+		if err != nil {
+			c.l.Error(err, "get item id param error")
+			return errorResponse(ctx, 400, "get item id param error")
+		}
+		imageID, err := ctx.ParamsInt("imageId")
+		if err != nil {
+			c.l.Error(err, "get image id param error")
+			return errorResponse(ctx, 400, "get image id param error")
+		}
+
+		image, r, myerr := c.s.Open(ctx.Context(), id, imageID, thumbnail)
+		if myerr.IsErr() {
+			c.l.Error(myerr.Err, "open item image error")
+			return errorResponse(ctx, myerr.Code, myerr.Message)
+		}
+
+		// the stream is closed by fasthttp when it is sent
+		ctx.Set(fiber.HeaderCacheControl, _imageCacheControl)
+		if thumbnail {
+			ctx.Set(fiber.HeaderContentType, model.ThumbnailContentType)
+			return ctx.SendStream(r)
+		}
+		ctx.Set(fiber.HeaderContentType, image.ContentType)
+		return ctx.SendStream(r, int(image.Size))
+	}
+}
+
+func (c *imageController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+	imageID, err := ctx.ParamsInt("imageId")
+	if err != nil {
+		c.l.Error(err, "get image id param error")
+		return errorResponse(ctx, 400, "get image id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id, imageID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete item image error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
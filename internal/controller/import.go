@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type importController struct {
+	s service.Import
+	l logger.Logger
+}
+
+func newImportController(router fiber.Router, l logger.Logger, importService service.Import) {
+	c := &importController{
+		s: importService,
+		l: l,
+	}
+
+	r := router.Group("/import")
+
+	r.Post("/item-details", c.itemDetails)
+}
+
+type importParams struct {
+	DryRun bool `query:"dry_run"`
+}
+
+// itemDetails accepts csv as multipart "file" field or as the raw request body.
+func (c *importController) itemDetails(ctx *fiber.Ctx) error {
+	var params importParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	var file io.Reader = bytes.NewReader(ctx.Body())
+	if fileHeader, err := ctx.FormFile("file"); err == nil {
+		f, err := fileHeader.Open()
+		if err != nil {
+			c.l.Error(err, "open import file error")
+			return errorResponse(ctx, 400, "open import file error")
+		}
+		defer f.Close()
+		file = f
+	}
+
+	report, myerr := c.s.ItemDetails(ctx.Context(), file, params.DryRun)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "import item details error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(report)
+}
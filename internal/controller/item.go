@@ -2,19 +2,22 @@ package controller
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
 	"github.com/lmnq/test-thai/internal/service"
 	"github.com/lmnq/test-thai/logger"
 )
 
 type itemController struct {
-	s service.Item
-	l logger.Logger
+	s           service.Item
+	translation service.Translation
+	l           logger.Logger
 }
 
-func newItemController(router fiber.Router, l logger.Logger, itemService service.Item) {
+func newItemController(router fiber.Router, l logger.Logger, itemService service.Item, translationService service.Translation) {
 	c := &itemController{
-		s: itemService,
-		l: l,
+		s:           itemService,
+		translation: translationService,
+		l:           l,
 	}
 
 	r := router.Group("/item")
@@ -23,6 +26,7 @@ func newItemController(router fiber.Router, l logger.Logger, itemService service
 	r.Get("/:id", c.get)
 	r.Get("/", c.getAll)
 	r.Put("/:id", c.update)
+	r.Patch("/:id", c.patch)
 	r.Delete("/:id", c.delete)
 }
 
@@ -56,24 +60,73 @@ func (c *itemController) get(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, 400, "get item id param error")
 	}
 
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	item, myerr := c.s.Get(ctx.Context(), id)
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get item error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeItems(ctx.Context(), locale, item); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize item error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(item)
 }
 
+type itemFilterParams struct {
+	Q        string `query:"q"`
+	Tags     string `query:"tags"`      // comma separated tag names
+	TagsMode string `query:"tags_mode"` // any or all of the tags, any by default
+}
+
 func (c *itemController) getAll(ctx *fiber.Ctx) error {
-	items, myerr := c.s.GetAll(ctx.Context())
+	var (
+		params     itemFilterParams
+		pageParams pageParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&pageParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	filter := &model.ItemFilter{
+		Q:        searchQuery(params.Q),
+		Tags:     tagsQuery(params.Tags),
+		TagsMode: params.TagsMode,
+	}
+	items, pageInfo, myerr := c.s.GetAll(ctx.Context(), filter, pageParams.page())
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get all items error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.translation.LocalizeItems(ctx.Context(), locale, items...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize item list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"items": items,
+		"items":       items,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
 	})
 }
 
@@ -104,6 +157,39 @@ func (c *itemController) update(ctx *fiber.Ctx) error {
 	return ctx.SendStatus(fiber.StatusOK)
 }
 
+type itemPatchRequest struct {
+	ItemName patchField[string] `json:"item_name"`
+}
+
+func (c *itemController) patch(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+
+	var req itemPatchRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	name, err := req.ItemName.get("item_name")
+	if err != nil {
+		c.l.Error(err, "patch item error")
+		return errorResponse(ctx, 400, err.Error())
+	}
+
+	myerr := c.s.Patch(ctx.Context(), id, name)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch item error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
 func (c *itemController) delete(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -4,38 +4,67 @@ import (
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/errs"
 	"github.com/lmnq/test-thai/internal/model"
 	"github.com/lmnq/test-thai/internal/service"
 	"github.com/lmnq/test-thai/logger"
+	"github.com/shopspring/decimal"
 )
 
 type itemDetailController struct {
-	s service.ItemDetail
-	l logger.Logger
+	s            service.ItemDetail
+	exchangeRate service.ExchangeRate
+	promotion    service.Promotion
+	translation  service.Translation
+	l            logger.Logger
 }
 
-func newItemDetailController(router fiber.Router, l logger.Logger, itemDetailService service.ItemDetail) {
+func newItemDetailController(
+	router fiber.Router,
+	l logger.Logger,
+	itemDetailService service.ItemDetail,
+	exchangeRateService service.ExchangeRate,
+	promotionService service.Promotion,
+	translationService service.Translation,
+) {
 	c := &itemDetailController{
-		s: itemDetailService,
-		l: l,
+		s:            itemDetailService,
+		exchangeRate: exchangeRateService,
+		promotion:    promotionService,
+		translation:  translationService,
+		l:            l,
 	}
 
 	r := router.Group("/item-detail")
 
 	r.Post("/", c.create)
+	r.Post("/bulk", c.bulk)
+	r.Get("/grouped", c.getAllGrouped)
+	r.Get("/by-barcode/:code", c.getByBarcode)
 	r.Get("/:id", c.get)
+	r.Get("/:id/price-history", c.priceHistory)
+	r.Get("/:id/price", c.priceAt)
+	r.Get("/:id/promotional-price", c.promotionalPrice)
+	r.Post("/:id/scheduled-prices", c.schedulePrice)
+	r.Get("/:id/scheduled-prices", c.scheduledPrices)
+	r.Delete("/:id/scheduled-prices/:scheduledPriceId", c.cancelScheduledPrice)
 	r.Get("/", c.getAllFilter)
 	r.Put("/:id", c.update)
+	r.Patch("/:id", c.patch)
 	r.Delete("/:id", c.delete)
 }
 
 type itemDetailCreateRequest struct {
-	ItemName   string  `json:"item_name"`
-	GroupID    int     `json:"group_id"`
-	CategoryID int     `json:"category_id"`
-	Cost       float64 `json:"cost"`
-	Price      float64 `json:"price"`
-	Sort       int     `json:"sort"`
+	ItemName    string  `json:"item_name"`
+	VariantName *string `json:"variant_name"` // e.g. small or large, not a variant if empty
+	SKU         *string `json:"sku"`          // no sku if empty
+	Barcode     *string `json:"barcode"`      // EAN-13 or UPC-A, no barcode if empty
+	GroupID     int     `json:"group_id"`
+	CategoryID  int     `json:"category_id"`
+	Cost        float64 `json:"cost"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"` // default currency if empty
+	Sort        int     `json:"sort"`
 }
 
 func (c *itemDetailController) create(ctx *fiber.Ctx) error {
@@ -47,11 +76,15 @@ func (c *itemDetailController) create(ctx *fiber.Ctx) error {
 	}
 
 	id, myerr := c.s.Create(ctx.Context(), &model.ItemDetail{
-		GroupID:    req.GroupID,
-		CategoryID: req.CategoryID,
-		Cost:       req.Cost,
-		Price:      req.Price,
-		Sort:       req.Sort,
+		VariantName: req.VariantName,
+		SKU:         req.SKU,
+		Barcode:     req.Barcode,
+		GroupID:     req.GroupID,
+		CategoryID:  req.CategoryID,
+		Cost:        req.Cost,
+		Price:       req.Price,
+		Currency:    req.Currency,
+		Sort:        req.Sort,
 	}, req.ItemName)
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "create item detail error")
@@ -63,6 +96,21 @@ func (c *itemDetailController) create(ctx *fiber.Ctx) error {
 	})
 }
 
+// currencyParams selects the currency of the item detail prices,
+// prices are in their own currency if it is empty.
+type currencyParams struct {
+	Currency string `query:"currency"`
+}
+
+// convert converts prices of the item details to the currency of the params.
+func (c *itemDetailController) convert(ctx *fiber.Ctx, params currencyParams, itemDetails ...*model.ItemDetailView) errs.Error {
+	if params.Currency == "" {
+		return errs.NilError()
+	}
+
+	return c.exchangeRate.ConvertItemDetails(ctx.Context(), params.Currency, itemDetails...)
+}
+
 func (c *itemDetailController) get(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -70,42 +118,95 @@ func (c *itemDetailController) get(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, 400, "get item detail id param error")
 	}
 
-	itemDetail, myerr := c.s.Get(ctx.Context(), id)
+	return c.view(ctx, func() (*model.ItemDetailView, errs.Error) {
+		return c.s.Get(ctx.Context(), id)
+	})
+}
+
+// getByBarcode is the lookup of the scanners, code is EAN-13 or UPC-A.
+func (c *itemDetailController) getByBarcode(ctx *fiber.Ctx) error {
+	return c.view(ctx, func() (*model.ItemDetailView, errs.Error) {
+		return c.s.GetByBarcode(ctx.Context(), ctx.Params("code"))
+	})
+}
+
+// view responds with the single item detail got by get,
+// converted to the currency of the query and localized to the request locale.
+func (c *itemDetailController) view(ctx *fiber.Ctx, get func() (*model.ItemDetailView, errs.Error)) error {
+	var currencyParams currencyParams
+
+	if err := ctx.QueryParser(&currencyParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	itemDetail, myerr := get()
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get item detail error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.convert(ctx, currencyParams, itemDetail); myerr.IsErr() {
+		c.l.Error(myerr.Err, "convert item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	if myerr := c.translation.LocalizeItemDetails(ctx.Context(), locale, itemDetail); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(itemDetail)
 }
 
 type itemDetailFilterParams struct {
-	ID           string  `query:"id"`
-	ItemName     *string `query:"item_name"`
-	CategoryName *string `query:"category_name"`
-	GroupName    *string `query:"group_name"`
+	ID               string           `query:"id"`
+	Q                string           `query:"q"`
+	ItemName         *string          `query:"item_name"`
+	CategoryID       *int             `query:"category_id"`
+	CategoryName     *string          `query:"category_name"`
+	Subcategories    bool             `query:"include_subcategories"` // category_id and category_name match the sub-categories too
+	GroupName        *string          `query:"group_name"`
+	Tags             string           `query:"tags"`      // comma separated tag names
+	TagsMode         string           `query:"tags_mode"` // any or all of the tags, any by default
+	MinMarginPercent *decimal.Decimal `query:"min_margin_percent"`
+	MaxMarginPercent *decimal.Decimal `query:"max_margin_percent"`
+	OrderBy          string           `query:"order_by"`
 }
 
-func (c *itemDetailController) getAllFilter(ctx *fiber.Ctx) error {
-	var params itemDetailFilterParams
-
-	if err := ctx.QueryParser(&params); err != nil {
-		c.l.Error(err, "query parser error")
-		return errorResponse(ctx, 400, "query parser error")
+// filter builds item detail filter of the params.
+// It returns error message of the invalid param.
+func (p itemDetailFilterParams) filter() (*model.ItemDetailFilter, string) {
+	filter := &model.ItemDetailFilter{
+		Q:                    searchQuery(p.Q),
+		ItemName:             p.ItemName,
+		CategoryID:           p.CategoryID,
+		CategoryName:         p.CategoryName,
+		IncludeSubcategories: p.Subcategories,
+		GroupName:            p.GroupName,
+		Tags:                 tagsQuery(p.Tags),
+		TagsMode:             p.TagsMode,
+		MinMarginPercent:     p.MinMarginPercent,
+		MaxMarginPercent:     p.MaxMarginPercent,
 	}
 
-	filter := &model.ItemDetailFilter{
-		ItemName:     params.ItemName,
-		CategoryName: params.CategoryName,
-		GroupName:    params.GroupName,
+	orderBy, err := parseOrderBy(p.OrderBy)
+	if err != nil {
+		return nil, "get item detail order_by param error"
 	}
+	filter.OrderBy = orderBy
 
 	id := 0
-	if params.ID != "" {
-		idParam, err := strconv.Atoi(params.ID)
+	if p.ID != "" {
+		idParam, err := strconv.Atoi(p.ID)
 		if err != nil {
-			c.l.Error(err, "get item detail id param error")
-			return errorResponse(ctx, 400, "get item detail id param error")
+			return nil, "get item detail id param error"
 		}
 		id = idParam
 	}
@@ -113,24 +214,134 @@ func (c *itemDetailController) getAllFilter(ctx *fiber.Ctx) error {
 		filter.ID = &id
 	}
 
-	itemDetails, myerr := c.s.GetAllFilter(ctx.Context(), filter)
+	return filter, ""
+}
+
+func (c *itemDetailController) getAllFilter(ctx *fiber.Ctx) error {
+	var (
+		params         itemDetailFilterParams
+		pageParams     pageParams
+		currencyParams currencyParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&pageParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&currencyParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	filter, errMsg := params.filter()
+	if errMsg != "" {
+		c.l.Error(errMsg)
+		return errorResponse(ctx, 400, errMsg)
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	itemDetails, pageInfo, myerr := c.s.GetAllFilter(ctx.Context(), filter, pageParams.page())
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "get item detail list error")
 		return errorResponse(ctx, myerr.Code, myerr.Message)
 	}
 
+	if myerr := c.convert(ctx, currencyParams, itemDetails...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "convert item detail list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	if myerr := c.translation.LocalizeItemDetails(ctx.Context(), locale, itemDetails...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize item detail list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"item_details": itemDetails,
+		"next_cursor":  pageInfo.NextCursor,
+		"total":        pageInfo.Total,
+	})
+}
+
+// getAllGrouped returns page of items with their item details by the same filters as getAllFilter,
+// so the variants of each item are together.
+func (c *itemDetailController) getAllGrouped(ctx *fiber.Ctx) error {
+	var (
+		params         itemDetailFilterParams
+		pageParams     pageParams
+		currencyParams currencyParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&pageParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&currencyParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	filter, errMsg := params.filter()
+	if errMsg != "" {
+		c.l.Error(errMsg)
+		return errorResponse(ctx, 400, errMsg)
+	}
+
+	locale, myerr := selectLocale(ctx, c.translation)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "select locale error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	items, pageInfo, myerr := c.s.GetAllGrouped(ctx.Context(), filter, pageParams.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get grouped item detail list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	for _, item := range items {
+		if myerr := c.convert(ctx, currencyParams, item.Variants...); myerr.IsErr() {
+			c.l.Error(myerr.Err, "convert grouped item detail list error")
+			return errorResponse(ctx, myerr.Code, myerr.Message)
+		}
+	}
+
+	if myerr := c.translation.LocalizeItemVariants(ctx.Context(), locale, items...); myerr.IsErr() {
+		c.l.Error(myerr.Err, "localize grouped item detail list error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"items":       items,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
 	})
 }
 
 type itemDetailUpdateRequest struct {
-	ItemName   string  `json:"item_name"`
-	GroupID    int     `json:"group_id"`
-	CategoryID int     `json:"category_id"`
-	Cost       float64 `json:"cost"`
-	Price      float64 `json:"price"`
-	Sort       int     `json:"sort"`
+	ItemName    string  `json:"item_name"`
+	VariantName *string `json:"variant_name"` // e.g. small or large, not a variant if empty
+	SKU         *string `json:"sku"`          // no sku if empty
+	Barcode     *string `json:"barcode"`      // EAN-13 or UPC-A, no barcode if empty
+	GroupID     int     `json:"group_id"`
+	CategoryID  int     `json:"category_id"`
+	Cost        float64 `json:"cost"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"` // default currency if empty
+	Sort        int     `json:"sort"`
 }
 
 func (c *itemDetailController) update(ctx *fiber.Ctx) error {
@@ -148,11 +359,15 @@ func (c *itemDetailController) update(ctx *fiber.Ctx) error {
 	}
 
 	myerr := c.s.Update(ctx.Context(), id, req.ItemName, &model.ItemDetail{
-		GroupID:    req.GroupID,
-		CategoryID: req.CategoryID,
-		Cost:       req.Cost,
-		Price:      req.Price,
-		Sort:       req.Sort,
+		VariantName: req.VariantName,
+		SKU:         req.SKU,
+		Barcode:     req.Barcode,
+		GroupID:     req.GroupID,
+		CategoryID:  req.CategoryID,
+		Cost:        req.Cost,
+		Price:       req.Price,
+		Currency:    req.Currency,
+		Sort:        req.Sort,
 	})
 	if myerr.IsErr() {
 		c.l.Error(myerr.Err, "update item detail error")
@@ -162,6 +377,91 @@ func (c *itemDetailController) update(ctx *fiber.Ctx) error {
 	return ctx.SendStatus(fiber.StatusOK)
 }
 
+type itemDetailPatchRequest struct {
+	ItemName    patchField[string]  `json:"item_name"`
+	VariantName patchField[string]  `json:"variant_name"` // empty or null makes the item detail not a variant
+	SKU         patchField[string]  `json:"sku"`          // empty or null removes the sku
+	Barcode     patchField[string]  `json:"barcode"`      // empty or null removes the barcode
+	GroupID     patchField[int]     `json:"group_id"`
+	CategoryID  patchField[int]     `json:"category_id"`
+	Cost        patchField[float64] `json:"cost"`
+	Price       patchField[float64] `json:"price"`
+	Currency    patchField[string]  `json:"currency"`
+	Sort        patchField[int]     `json:"sort"`
+}
+
+func (r itemDetailPatchRequest) patch() (*model.ItemDetailPatch, error) {
+	var (
+		patch model.ItemDetailPatch
+		err   error
+	)
+	if patch.ItemName, err = r.ItemName.get("item_name"); err != nil {
+		return nil, err
+	}
+	// variant name, sku and barcode are the only nullable fields, null is the same as empty
+	r.VariantName.Null = false
+	if patch.VariantName, err = r.VariantName.get("variant_name"); err != nil {
+		return nil, err
+	}
+	r.SKU.Null = false
+	if patch.SKU, err = r.SKU.get("sku"); err != nil {
+		return nil, err
+	}
+	r.Barcode.Null = false
+	if patch.Barcode, err = r.Barcode.get("barcode"); err != nil {
+		return nil, err
+	}
+	if patch.GroupID, err = r.GroupID.get("group_id"); err != nil {
+		return nil, err
+	}
+	if patch.CategoryID, err = r.CategoryID.get("category_id"); err != nil {
+		return nil, err
+	}
+	if patch.Cost, err = r.Cost.get("cost"); err != nil {
+		return nil, err
+	}
+	if patch.Price, err = r.Price.get("price"); err != nil {
+		return nil, err
+	}
+	if patch.Currency, err = r.Currency.get("currency"); err != nil {
+		return nil, err
+	}
+	if patch.Sort, err = r.Sort.get("sort"); err != nil {
+		return nil, err
+	}
+
+	return &patch, nil
+}
+
+func (c *itemDetailController) patch(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var req itemDetailPatchRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	patch, err := req.patch()
+	if err != nil {
+		c.l.Error(err, "patch item detail error")
+		return errorResponse(ctx, 400, err.Error())
+	}
+
+	myerr := c.s.Patch(ctx.Context(), id, patch)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "patch item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
 func (c *itemDetailController) delete(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -177,3 +477,74 @@ func (c *itemDetailController) delete(ctx *fiber.Ctx) error {
 
 	return ctx.SendStatus(fiber.StatusOK)
 }
+
+type itemDetailBulkRequest struct {
+	Mode       string                    `json:"mode"` // all_or_nothing (default) or best_effort
+	Operations []itemDetailBulkOpRequest `json:"operations"`
+}
+
+type itemDetailBulkOpRequest struct {
+	Op          string  `json:"op"` // create, update or delete
+	ID          int     `json:"id"`
+	ItemName    string  `json:"item_name"`
+	VariantName *string `json:"variant_name"` // e.g. small or large, not a variant if empty
+	SKU         *string `json:"sku"`          // no sku if empty
+	Barcode     *string `json:"barcode"`      // EAN-13 or UPC-A, no barcode if empty
+	GroupID     int     `json:"group_id"`
+	CategoryID  int     `json:"category_id"`
+	Cost        float64 `json:"cost"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"` // default currency if empty
+	Sort        int     `json:"sort"`
+}
+
+func (c *itemDetailController) bulk(ctx *fiber.Ctx) error {
+	var req itemDetailBulkRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	ops := make([]*model.ItemDetailBulkOp, 0, len(req.Operations))
+	for _, op := range req.Operations {
+		ops = append(ops, &model.ItemDetailBulkOp{
+			Op:       op.Op,
+			ID:       op.ID,
+			ItemName: op.ItemName,
+			ItemDetail: &model.ItemDetail{
+				VariantName: op.VariantName,
+				SKU:         op.SKU,
+				Barcode:     op.Barcode,
+				GroupID:     op.GroupID,
+				CategoryID:  op.CategoryID,
+				Cost:        op.Cost,
+				Price:       op.Price,
+				Currency:    op.Currency,
+				Sort:        op.Sort,
+			},
+		})
+	}
+
+	results, myerr := c.s.Bulk(ctx.Context(), req.Mode, ops)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "bulk item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	applied, failed := 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case model.BulkStatusApplied:
+			applied++
+		case model.BulkStatusFailed:
+			failed++
+		}
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"applied": applied,
+		"failed":  failed,
+		"results": results,
+	})
+}
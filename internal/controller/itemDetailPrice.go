@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+const _dateLayout = "2006-01-02"
+
+type priceHistoryParams struct {
+	From string `query:"from"` // RFC 3339 time or date, inclusive
+	To   string `query:"to"`   // RFC 3339 time, exclusive, or date, inclusive
+}
+
+type priceAtParams struct {
+	At string `query:"at"` // RFC 3339 time, now by default
+}
+
+// parseTime parses RFC 3339 time or date, dateEnd moves the date to the end of the day.
+func parseTime(s string, dateEnd bool) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(_dateLayout, s)
+	if err != nil {
+		return nil, err
+	}
+	if dateEnd {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return &t, nil
+}
+
+func (c *itemDetailController) priceHistory(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var params priceHistoryParams
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	var filter model.PriceHistoryFilter
+	if filter.From, err = parseTime(params.From, false); err != nil {
+		c.l.Error(err, "get price history from param error")
+		return errorResponse(ctx, 400, "get price history from param error")
+	}
+	if filter.To, err = parseTime(params.To, true); err != nil {
+		c.l.Error(err, "get price history to param error")
+		return errorResponse(ctx, 400, "get price history to param error")
+	}
+
+	prices, myerr := c.s.PriceHistory(ctx.Context(), id, &filter)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail price history error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"prices": prices,
+	})
+}
+
+func (c *itemDetailController) priceAt(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var params priceAtParams
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	at := time.Now()
+	if params.At != "" {
+		t, err := time.Parse(time.RFC3339, params.At)
+		if err != nil {
+			c.l.Error(err, "get price at param error")
+			return errorResponse(ctx, 400, "get price at param error")
+		}
+		at = t
+	}
+
+	price, myerr := c.s.PriceAt(ctx.Context(), id, at)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail price error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(price)
+}
+
+type promotionalPriceParams struct {
+	Quantity int    `query:"quantity"` // 1 by default
+	At       string `query:"at"`       // RFC 3339 time, now by default, weekdays are in its offset
+}
+
+func (c *itemDetailController) promotionalPrice(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	params := promotionalPriceParams{Quantity: 1}
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	at := time.Now()
+	if params.At != "" {
+		t, err := time.Parse(time.RFC3339, params.At)
+		if err != nil {
+			c.l.Error(err, "get promotional price at param error")
+			return errorResponse(ctx, 400, "get promotional price at param error")
+		}
+		at = t
+	}
+
+	price, myerr := c.promotion.PromotionalPrice(ctx.Context(), id, params.Quantity, at)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail promotional price error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(price)
+}
+
+type scheduledPriceRequest struct {
+	Cost          float64   `json:"cost"`
+	Price         float64   `json:"price"`
+	EffectiveFrom time.Time `json:"effective_from"` // RFC 3339 time in the future
+}
+
+func (c *itemDetailController) schedulePrice(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var req scheduledPriceRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	scheduledPriceID, myerr := c.s.SchedulePrice(ctx.Context(), &model.ItemDetailScheduledPrice{
+		ItemDetailID:  id,
+		Cost:          req.Cost,
+		Price:         req.Price,
+		EffectiveFrom: req.EffectiveFrom,
+	})
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "schedule item detail price error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": scheduledPriceID,
+	})
+}
+
+func (c *itemDetailController) scheduledPrices(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	scheduledPrices, myerr := c.s.ScheduledPrices(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get item detail scheduled prices error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"scheduled_prices": scheduledPrices,
+	})
+}
+
+func (c *itemDetailController) cancelScheduledPrice(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+	scheduledPriceID, err := ctx.ParamsInt("scheduledPriceId")
+	if err != nil {
+		c.l.Error(err, "get scheduled price id param error")
+		return errorResponse(ctx, 400, "get scheduled price id param error")
+	}
+
+	myerr := c.s.CancelScheduledPrice(ctx.Context(), id, scheduledPriceID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "cancel item detail scheduled price error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,331 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type modifierGroupController struct {
+	s service.ModifierGroup
+	l logger.Logger
+}
+
+func newModifierGroupController(router fiber.Router, l logger.Logger, modifierGroupService service.ModifierGroup) {
+	c := &modifierGroupController{
+		s: modifierGroupService,
+		l: l,
+	}
+
+	r := router.Group("/modifier-group")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+	r.Post("/:id/modifiers", c.createModifier)
+	r.Put("/:id/modifiers/:modifierId", c.updateModifier)
+	r.Delete("/:id/modifiers/:modifierId", c.deleteModifier)
+
+	router.Post("/item-detail/:id/modifier-groups", c.attachToItemDetail)
+	router.Delete("/item-detail/:id/modifier-groups/:modifierGroupId", c.detachFromItemDetail)
+	router.Post("/category/:id/modifier-groups", c.attachToCategory)
+	router.Delete("/category/:id/modifier-groups/:modifierGroupId", c.detachFromCategory)
+}
+
+// modifierGroupRequest is the modifier group of create and update.
+type modifierGroupRequest struct {
+	ModifierGroupName string `json:"modifier_group_name"`
+	MinSelections     int    `json:"min_selections"`
+	MaxSelections     int    `json:"max_selections"`
+}
+
+func (r modifierGroupRequest) modifierGroup() *model.ModifierGroup {
+	return &model.ModifierGroup{
+		ModifierGroupName: r.ModifierGroupName,
+		MinSelections:     r.MinSelections,
+		MaxSelections:     r.MaxSelections,
+	}
+}
+
+// modifierRequest is the modifier of create and update.
+type modifierRequest struct {
+	ModifierName string  `json:"modifier_name"`
+	PriceDelta   float64 `json:"price_delta"` // added to the item detail price, in its currency
+	Sort         int     `json:"sort"`
+}
+
+// modifierGroupAttachRequest attaches the modifier group to the item detail or the category.
+type modifierGroupAttachRequest struct {
+	ModifierGroupID int `json:"modifier_group_id"`
+}
+
+func (c *modifierGroupController) create(ctx *fiber.Ctx) error {
+	var req modifierGroupRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.modifierGroup())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create modifier group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *modifierGroupController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	group, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get modifier group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(group)
+}
+
+func (c *modifierGroupController) getAll(ctx *fiber.Ctx) error {
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	groups, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all modifier groups error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"modifier_groups": groups,
+		"next_cursor":     pageInfo.NextCursor,
+		"total":           pageInfo.Total,
+	})
+}
+
+func (c *modifierGroupController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	var req modifierGroupRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.modifierGroup())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update modifier group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete modifier group error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) createModifier(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	var req modifierRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	modifierID, myerr := c.s.CreateModifier(ctx.Context(), &model.Modifier{
+		ModifierGroupID: id,
+		ModifierName:    req.ModifierName,
+		PriceDelta:      req.PriceDelta,
+		Sort:            req.Sort,
+	})
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create modifier error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": modifierID,
+	})
+}
+
+func (c *modifierGroupController) updateModifier(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+	modifierID, err := ctx.ParamsInt("modifierId")
+	if err != nil {
+		c.l.Error(err, "get modifier id param error")
+		return errorResponse(ctx, 400, "get modifier id param error")
+	}
+
+	var req modifierRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.UpdateModifier(ctx.Context(), &model.Modifier{
+		ID:              modifierID,
+		ModifierGroupID: id,
+		ModifierName:    req.ModifierName,
+		PriceDelta:      req.PriceDelta,
+		Sort:            req.Sort,
+	})
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update modifier error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) deleteModifier(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+	modifierID, err := ctx.ParamsInt("modifierId")
+	if err != nil {
+		c.l.Error(err, "get modifier id param error")
+		return errorResponse(ctx, 400, "get modifier id param error")
+	}
+
+	myerr := c.s.DeleteModifier(ctx.Context(), id, modifierID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete modifier error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) attachToItemDetail(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var req modifierGroupAttachRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.AttachToItemDetail(ctx.Context(), id, req.ModifierGroupID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "attach modifier group to item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) detachFromItemDetail(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+	groupID, err := ctx.ParamsInt("modifierGroupId")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	myerr := c.s.DetachFromItemDetail(ctx.Context(), id, groupID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "detach modifier group from item detail error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) attachToCategory(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+
+	var req modifierGroupAttachRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.AttachToCategory(ctx.Context(), id, req.ModifierGroupID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "attach modifier group to category error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *modifierGroupController) detachFromCategory(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get category id param error")
+		return errorResponse(ctx, 400, "get category id param error")
+	}
+	groupID, err := ctx.ParamsInt("modifierGroupId")
+	if err != nil {
+		c.l.Error(err, "get modifier group id param error")
+		return errorResponse(ctx, 400, "get modifier group id param error")
+	}
+
+	myerr := c.s.DetachFromCategory(ctx.Context(), id, groupID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "detach modifier group from category error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,580 @@
+package controller
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/export"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+// apiOperation describes a route of the API for the OpenAPI document.
+// query, body and response are values of the types the handler uses,
+// the schemas are generated from them, so the document follows the handler types.
+// every registered route must be described here, it is checked by openapi_test.go.
+type apiOperation struct {
+	method        string
+	path          string // fiber route path, e.g. /item/:id
+	tag           string
+	summary       string
+	query         []interface{} // structs with query tags
+	body          interface{}   // json request body
+	bodyTypes     []string      // content types of the non json request body
+	status        int           // success status
+	response      interface{}   // json response body
+	responseTypes []string      // content types of the non json response body
+}
+
+// responses of the list and create routes
+type (
+	createResponse struct {
+		ID int `json:"id"`
+	}
+	itemListResponse struct {
+		Items      []*model.Item `json:"items"`
+		NextCursor *string       `json:"next_cursor"`
+		Total      int           `json:"total"`
+	}
+	categoryListResponse struct {
+		Categories []*model.Category `json:"categories"`
+		NextCursor *string           `json:"next_cursor"`
+		Total      int               `json:"total"`
+	}
+	categoryTreeResponse struct {
+		Categories []*model.CategoryNode `json:"categories"`
+	}
+	groupListResponse struct {
+		Groups     []*model.Group `json:"groups"`
+		NextCursor *string        `json:"next_cursor"`
+		Total      int            `json:"total"`
+	}
+	itemDetailListResponse struct {
+		ItemDetails []*model.ItemDetailView `json:"item_details"`
+		NextCursor  *string                 `json:"next_cursor"`
+		Total       int                     `json:"total"`
+	}
+	itemVariantsListResponse struct {
+		Items      []*model.ItemVariants `json:"items"`
+		NextCursor *string               `json:"next_cursor"`
+		Total      int                   `json:"total"`
+	}
+	exchangeRateListResponse struct {
+		ExchangeRates []*model.ExchangeRate `json:"exchange_rates"`
+		NextCursor    *string               `json:"next_cursor"`
+		Total         int                   `json:"total"`
+	}
+	taxProfileListResponse struct {
+		TaxProfiles []*model.TaxProfile `json:"tax_profiles"`
+		NextCursor  *string             `json:"next_cursor"`
+		Total       int                 `json:"total"`
+	}
+	promotionListResponse struct {
+		Promotions []*model.Promotion `json:"promotions"`
+		NextCursor *string            `json:"next_cursor"`
+		Total      int                `json:"total"`
+	}
+	modifierGroupListResponse struct {
+		ModifierGroups []*model.ModifierGroup `json:"modifier_groups"`
+		NextCursor     *string                `json:"next_cursor"`
+		Total          int                    `json:"total"`
+	}
+	bundleListResponse struct {
+		Bundles    []*model.Bundle `json:"bundles"`
+		NextCursor *string         `json:"next_cursor"`
+		Total      int             `json:"total"`
+	}
+	tagListResponse struct {
+		Tags       []*model.Tag `json:"tags"`
+		NextCursor *string      `json:"next_cursor"`
+		Total      int          `json:"total"`
+	}
+	imageListResponse struct {
+		Images []*model.ItemImage `json:"images"`
+	}
+	stockMovementCreateResponse struct {
+		ID           int     `json:"id"`
+		BalanceAfter float64 `json:"balance_after"`
+	}
+	stockMovementListResponse struct {
+		Movements  []*model.StockMovement `json:"movements"`
+		NextCursor *string                `json:"next_cursor"`
+		Total      int                    `json:"total"`
+	}
+	stockBalanceListResponse struct {
+		Balances   []*model.StockBalance `json:"balances"`
+		NextCursor *string               `json:"next_cursor"`
+		Total      int                   `json:"total"`
+	}
+	translationListResponse struct {
+		Translations []*model.Translation `json:"translations"`
+	}
+	scheduledPriceListResponse struct {
+		ScheduledPrices []*model.ItemDetailScheduledPrice `json:"scheduled_prices"`
+	}
+	priceHistoryResponse struct {
+		Prices []*model.ItemDetailPrice `json:"prices"`
+	}
+	itemDetailBulkResponse struct {
+		Applied int                           `json:"applied"`
+		Failed  int                           `json:"failed"`
+		Results []*model.ItemDetailBulkResult `json:"results"`
+	}
+)
+
+var _apiOperations = []apiOperation{
+	// item
+	{method: fiber.MethodPost, path: "/item", tag: "item", summary: "Create item",
+		body: itemCreateRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/item/:id", tag: "item", summary: "Get item",
+		query: []interface{}{localeParams{}}, status: fiber.StatusOK, response: model.Item{}},
+	{method: fiber.MethodGet, path: "/item", tag: "item", summary: "Get page of items, q searches by name, tags selects items with any or all of the tags",
+		query: []interface{}{itemFilterParams{}, pageParams{}, localeParams{}}, status: fiber.StatusOK, response: itemListResponse{}},
+	{method: fiber.MethodPut, path: "/item/:id", tag: "item", summary: "Update item",
+		body: itemUpdateRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodPatch, path: "/item/:id", tag: "item", summary: "Partially update item",
+		body: itemPatchRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/item/:id", tag: "item", summary: "Delete item",
+		status: fiber.StatusOK},
+	{method: fiber.MethodGet, path: "/item/:id/translations", tag: "item", summary: "Get translations of item name",
+		status: fiber.StatusOK, response: translationListResponse{}},
+	{method: fiber.MethodPut, path: "/item/:id/translations/:locale", tag: "item", summary: "Create or replace translation of item name to the locale, e.g. en",
+		body: translationRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/item/:id/translations/:locale", tag: "item", summary: "Delete translation of item name",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPost, path: "/item/:id/tags", tag: "item", summary: "Add tag to item",
+		body: tagAttachRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/item/:id/tags/:tagId", tag: "item", summary: "Remove tag from item",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPost, path: "/item/:id/images", tag: "item", summary: "Upload JPEG, PNG or WebP image of item as multipart image field or as request body, thumbnail is created",
+		bodyTypes: []string{fiber.MIMEMultipartForm, "image/jpeg", "image/png", "image/webp"}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/item/:id/images", tag: "item", summary: "Get images of item in upload order",
+		status: fiber.StatusOK, response: imageListResponse{}},
+	{method: fiber.MethodGet, path: "/item/:id/images/:imageId", tag: "item", summary: "Download image of item",
+		status: fiber.StatusOK, responseTypes: []string{"image/jpeg", "image/png", "image/webp"}},
+	{method: fiber.MethodGet, path: "/item/:id/images/:imageId/thumbnail", tag: "item", summary: "Download JPEG thumbnail of item image",
+		status: fiber.StatusOK, responseTypes: []string{model.ThumbnailContentType}},
+	{method: fiber.MethodDelete, path: "/item/:id/images/:imageId", tag: "item", summary: "Delete image of item with its thumbnail",
+		status: fiber.StatusOK},
+
+	// category
+	{method: fiber.MethodPost, path: "/category", tag: "category", summary: "Create category",
+		body: categoryCreateRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/category/:id", tag: "category", summary: "Get category",
+		query: []interface{}{localeParams{}}, status: fiber.StatusOK, response: model.Category{}},
+	{method: fiber.MethodGet, path: "/category/tree", tag: "category", summary: "Get top level categories with their sub-categories, siblings are ordered by name",
+		query: []interface{}{localeParams{}}, status: fiber.StatusOK, response: categoryTreeResponse{}},
+	{method: fiber.MethodGet, path: "/category", tag: "category", summary: "Get page of categories",
+		query: []interface{}{pageParams{}, localeParams{}}, status: fiber.StatusOK, response: categoryListResponse{}},
+	{method: fiber.MethodPut, path: "/category/:id", tag: "category", summary: "Update category",
+		body: categoryUpdateRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodPatch, path: "/category/:id", tag: "category", summary: "Partially update category",
+		body: categoryPatchRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/category/:id", tag: "category", summary: "Delete category, its sub-categories are moved to its parent",
+		status: fiber.StatusOK},
+	{method: fiber.MethodGet, path: "/category/:id/translations", tag: "category", summary: "Get translations of category name",
+		status: fiber.StatusOK, response: translationListResponse{}},
+	{method: fiber.MethodPut, path: "/category/:id/translations/:locale", tag: "category", summary: "Create or replace translation of category name to the locale, e.g. en",
+		body: translationRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/category/:id/translations/:locale", tag: "category", summary: "Delete translation of category name",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPut, path: "/category/:id/tax-profile", tag: "category", summary: "Attach tax profile to category, it is used before the group profile",
+		body: categoryTaxProfileRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/category/:id/tax-profile", tag: "category", summary: "Detach tax profile from category",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPut, path: "/category/:id/parent", tag: "category", summary: "Move category under parent category, the parent must not be its sub-category",
+		body: categoryParentRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/category/:id/parent", tag: "category", summary: "Make category top level",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPost, path: "/category/:id/modifier-groups", tag: "category", summary: "Attach modifier group to all item details of category, it must not be attached to any of them",
+		body: modifierGroupAttachRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/category/:id/modifier-groups/:modifierGroupId", tag: "category", summary: "Detach modifier group from category",
+		status: fiber.StatusOK},
+
+	// group
+	{method: fiber.MethodPost, path: "/group", tag: "group", summary: "Create group",
+		body: groupCreateRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/group/:id", tag: "group", summary: "Get group",
+		query: []interface{}{localeParams{}}, status: fiber.StatusOK, response: model.Group{}},
+	{method: fiber.MethodGet, path: "/group", tag: "group", summary: "Get page of groups",
+		query: []interface{}{pageParams{}, localeParams{}}, status: fiber.StatusOK, response: groupListResponse{}},
+	{method: fiber.MethodPut, path: "/group/:id", tag: "group", summary: "Update group",
+		body: groupUpdateRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodPatch, path: "/group/:id", tag: "group", summary: "Partially update group",
+		body: groupPatchRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/group/:id", tag: "group", summary: "Delete group",
+		status: fiber.StatusOK},
+	{method: fiber.MethodGet, path: "/group/:id/translations", tag: "group", summary: "Get translations of group name",
+		status: fiber.StatusOK, response: translationListResponse{}},
+	{method: fiber.MethodPut, path: "/group/:id/translations/:locale", tag: "group", summary: "Create or replace translation of group name to the locale, e.g. en",
+		body: translationRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/group/:id/translations/:locale", tag: "group", summary: "Delete translation of group name",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPut, path: "/group/:id/tax-profile", tag: "group", summary: "Attach tax profile to group, it is used if the category has no profile",
+		body: groupTaxProfileRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/group/:id/tax-profile", tag: "group", summary: "Detach tax profile from group",
+		status: fiber.StatusOK},
+
+	// item detail
+	{method: fiber.MethodPost, path: "/item-detail", tag: "item-detail", summary: "Create item detail, the item is created if it does not exist",
+		body: itemDetailCreateRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodPost, path: "/item-detail/bulk", tag: "item-detail", summary: "Apply create, update and delete operations in one transaction",
+		body: itemDetailBulkRequest{}, status: fiber.StatusOK, response: itemDetailBulkResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id", tag: "item-detail", summary: "Get item detail with modifier groups, currency converts the prices",
+		query: []interface{}{currencyParams{}, localeParams{}}, status: fiber.StatusOK, response: model.ItemDetailView{}},
+	{method: fiber.MethodGet, path: "/item-detail/by-barcode/:code", tag: "item-detail", summary: "Get item detail with modifier groups by EAN-13 or UPC-A barcode, UPC-A matches its EAN-13 form",
+		query: []interface{}{currencyParams{}, localeParams{}}, status: fiber.StatusOK, response: model.ItemDetailView{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/price-history", tag: "item-detail", summary: "Get cost and price changes of item detail, from and to are RFC 3339 times or dates",
+		query: []interface{}{priceHistoryParams{}}, status: fiber.StatusOK, response: priceHistoryResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/price", tag: "item-detail", summary: "Get cost and price of item detail at the time",
+		query: []interface{}{priceAtParams{}}, status: fiber.StatusOK, response: model.ItemDetailPrice{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/promotional-price", tag: "item-detail", summary: "Get price of the quantity of item detail with the promotions active at the time",
+		query: []interface{}{promotionalPriceParams{}}, status: fiber.StatusOK, response: model.PromotionalPrice{}},
+	{method: fiber.MethodPost, path: "/item-detail/:id/scheduled-prices", tag: "item-detail", summary: "Schedule cost and price change of item detail, it is applied at effective_from",
+		body: scheduledPriceRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/scheduled-prices", tag: "item-detail", summary: "Get pending cost and price changes of item detail",
+		status: fiber.StatusOK, response: scheduledPriceListResponse{}},
+	{method: fiber.MethodDelete, path: "/item-detail/:id/scheduled-prices/:scheduledPriceId", tag: "item-detail", summary: "Cancel pending cost and price change of item detail",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPost, path: "/item-detail/:id/modifier-groups", tag: "item-detail", summary: "Attach modifier group to item detail, it must not be attached to its category",
+		body: modifierGroupAttachRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/item-detail/:id/modifier-groups/:modifierGroupId", tag: "item-detail", summary: "Detach modifier group from item detail",
+		status: fiber.StatusOK},
+	{method: fiber.MethodGet, path: "/item-detail", tag: "item-detail", summary: "Get page of item details by filter, order_by is like price:desc,item_name, names match in any locale",
+		query: []interface{}{itemDetailFilterParams{}, pageParams{}, currencyParams{}, localeParams{}}, status: fiber.StatusOK, response: itemDetailListResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/grouped", tag: "item-detail", summary: "Get page of items with their item details by the filters of GET /item-detail, variants of each item together",
+		query: []interface{}{itemDetailFilterParams{}, pageParams{}, currencyParams{}, localeParams{}}, status: fiber.StatusOK, response: itemVariantsListResponse{}},
+	{method: fiber.MethodPut, path: "/item-detail/:id", tag: "item-detail", summary: "Update item detail",
+		body: itemDetailUpdateRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodPatch, path: "/item-detail/:id", tag: "item-detail", summary: "Partially update item detail",
+		body: itemDetailPatchRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/item-detail/:id", tag: "item-detail", summary: "Delete item detail",
+		status: fiber.StatusOK},
+
+	// exchange rate
+	{method: fiber.MethodPost, path: "/exchange-rate", tag: "exchange-rate", summary: "Create exchange rate, rate is the price in quote currency of one unit of base currency",
+		body: exchangeRateRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/exchange-rate/:id", tag: "exchange-rate", summary: "Get exchange rate",
+		status: fiber.StatusOK, response: model.ExchangeRate{}},
+	{method: fiber.MethodGet, path: "/exchange-rate", tag: "exchange-rate", summary: "Get page of exchange rates",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: exchangeRateListResponse{}},
+	{method: fiber.MethodPut, path: "/exchange-rate/:id", tag: "exchange-rate", summary: "Update exchange rate",
+		body: exchangeRateRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/exchange-rate/:id", tag: "exchange-rate", summary: "Delete exchange rate",
+		status: fiber.StatusOK},
+
+	// tax profile
+	{method: fiber.MethodPost, path: "/tax-profile", tag: "tax-profile", summary: "Create VAT and service charge profile",
+		body: taxProfileRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/tax-profile/:id", tag: "tax-profile", summary: "Get tax profile",
+		status: fiber.StatusOK, response: model.TaxProfile{}},
+	{method: fiber.MethodGet, path: "/tax-profile", tag: "tax-profile", summary: "Get page of tax profiles",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: taxProfileListResponse{}},
+	{method: fiber.MethodPut, path: "/tax-profile/:id", tag: "tax-profile", summary: "Update tax profile",
+		body: taxProfileRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/tax-profile/:id", tag: "tax-profile", summary: "Delete tax profile and detach it from categories and groups",
+		status: fiber.StatusOK},
+
+	// promotion
+	{method: fiber.MethodPost, path: "/promotion", tag: "promotion", summary: "Create promotion of item, category or group",
+		body: promotionRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/promotion/:id", tag: "promotion", summary: "Get promotion",
+		status: fiber.StatusOK, response: model.Promotion{}},
+	{method: fiber.MethodGet, path: "/promotion", tag: "promotion", summary: "Get page of promotions by target",
+		query: []interface{}{promotionFilterParams{}, pageParams{}}, status: fiber.StatusOK, response: promotionListResponse{}},
+	{method: fiber.MethodPut, path: "/promotion/:id", tag: "promotion", summary: "Update promotion",
+		body: promotionRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/promotion/:id", tag: "promotion", summary: "Delete promotion",
+		status: fiber.StatusOK},
+
+	// modifier group
+	{method: fiber.MethodPost, path: "/modifier-group", tag: "modifier-group", summary: "Create modifier group",
+		body: modifierGroupRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/modifier-group/:id", tag: "modifier-group", summary: "Get modifier group with modifiers",
+		status: fiber.StatusOK, response: model.ModifierGroup{}},
+	{method: fiber.MethodGet, path: "/modifier-group", tag: "modifier-group", summary: "Get page of modifier groups with modifiers",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: modifierGroupListResponse{}},
+	{method: fiber.MethodPut, path: "/modifier-group/:id", tag: "modifier-group", summary: "Update modifier group",
+		body: modifierGroupRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/modifier-group/:id", tag: "modifier-group", summary: "Delete modifier group with modifiers and detach it",
+		status: fiber.StatusOK},
+	{method: fiber.MethodPost, path: "/modifier-group/:id/modifiers", tag: "modifier-group", summary: "Create modifier of modifier group",
+		body: modifierRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodPut, path: "/modifier-group/:id/modifiers/:modifierId", tag: "modifier-group", summary: "Update modifier of modifier group",
+		body: modifierRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/modifier-group/:id/modifiers/:modifierId", tag: "modifier-group", summary: "Delete modifier of modifier group",
+		status: fiber.StatusOK},
+
+	// bundle
+	{method: fiber.MethodPost, path: "/bundle", tag: "bundle", summary: "Create bundle of item details with quantities",
+		body: bundleRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/bundle/:id", tag: "bundle", summary: "Get bundle with components, cost rolled up from them and manual or computed price",
+		query: []interface{}{localeParams{}}, status: fiber.StatusOK, response: model.Bundle{}},
+	{method: fiber.MethodGet, path: "/bundle", tag: "bundle", summary: "Get page of bundles, unavailable selects bundles with deleted components",
+		query: []interface{}{bundleFilterParams{}, pageParams{}, localeParams{}}, status: fiber.StatusOK, response: bundleListResponse{}},
+	{method: fiber.MethodPut, path: "/bundle/:id", tag: "bundle", summary: "Update bundle and replace its components",
+		body: bundleRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/bundle/:id", tag: "bundle", summary: "Delete bundle",
+		status: fiber.StatusOK},
+
+	// tag
+	{method: fiber.MethodPost, path: "/tag", tag: "tag", summary: "Create tag, the name is trimmed and lowercased",
+		body: tagRequest{}, status: fiber.StatusCreated, response: createResponse{}},
+	{method: fiber.MethodGet, path: "/tag/:id", tag: "tag", summary: "Get tag",
+		status: fiber.StatusOK, response: model.Tag{}},
+	{method: fiber.MethodGet, path: "/tag", tag: "tag", summary: "Get page of tags",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: tagListResponse{}},
+	{method: fiber.MethodPut, path: "/tag/:id", tag: "tag", summary: "Update tag",
+		body: tagRequest{}, status: fiber.StatusOK},
+	{method: fiber.MethodDelete, path: "/tag/:id", tag: "tag", summary: "Delete tag and remove it from items",
+		status: fiber.StatusOK},
+
+	// stock
+	{method: fiber.MethodPost, path: "/item-detail/:id/stock/movements", tag: "stock", summary: "Record receive, sell, adjust or waste of item detail, adjust quantity is signed, the others are positive",
+		body: stockMovementRequest{}, status: fiber.StatusCreated, response: stockMovementCreateResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/stock/movements", tag: "stock", summary: "Get page of stock movements of item detail, latest first",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: stockMovementListResponse{}},
+	{method: fiber.MethodGet, path: "/item-detail/:id/stock", tag: "stock", summary: "Get on-hand quantity of item detail, the balance after its last movement",
+		status: fiber.StatusOK, response: model.StockBalance{}},
+	{method: fiber.MethodGet, path: "/stock", tag: "stock", summary: "Get page of on-hand quantities of item details having stock movements",
+		query: []interface{}{pageParams{}}, status: fiber.StatusOK, response: stockBalanceListResponse{}},
+
+	// import, export
+	{method: fiber.MethodPost, path: "/import/item-details", tag: "import", summary: "Import item details from CSV, as multipart file field or as request body",
+		query: []interface{}{importParams{}}, bodyTypes: []string{fiber.MIMEMultipartForm, "text/csv"},
+		status: fiber.StatusOK, response: model.ItemDetailImportReport{}},
+	{method: fiber.MethodGet, path: "/export/item-details", tag: "export", summary: "Export item details by the filters of GET /item-detail, 429 while the max concurrent exports are running",
+		query: []interface{}{exportParams{}, itemDetailFilterParams{}, localeParams{}}, status: fiber.StatusOK,
+		responseTypes: []string{export.ContentType(export.FormatCSV), export.ContentType(export.FormatXLSX)}},
+
+	// docs
+	{method: fiber.MethodGet, path: "/openapi.json", tag: "docs", summary: "OpenAPI document",
+		status: fiber.StatusOK, responseTypes: []string{fiber.MIMEApplicationJSON}},
+	{method: fiber.MethodGet, path: "/docs", tag: "docs", summary: "API docs page",
+		status: fiber.StatusOK, responseTypes: []string{fiber.MIMETextHTMLCharsetUTF8}},
+}
+
+var _pathParamRegexp = regexp.MustCompile(`:(\w+)`)
+
+// openAPIPath converts fiber route path to OpenAPI path and returns names of its params.
+func openAPIPath(path string) (string, []string) {
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+
+	var params []string
+	for _, m := range _pathParamRegexp.FindAllStringSubmatch(path, -1) {
+		params = append(params, m[1])
+	}
+
+	return _pathParamRegexp.ReplaceAllString(path, "{$1}"), params
+}
+
+// openAPIDocument builds OpenAPI 3 document of the operations.
+func openAPIDocument(operations []apiOperation) map[string]interface{} {
+	g := &schemaGenerator{components: map[string]interface{}{}}
+	paths := map[string]map[string]interface{}{}
+
+	for _, op := range operations {
+		path, pathParams := openAPIPath(op.path)
+
+		params := []interface{}{}
+		for _, name := range pathParams {
+			params = append(params, map[string]interface{}{
+				"name": name, "in": "path", "required": true, "schema": map[string]interface{}{"type": "integer"},
+			})
+		}
+		for _, query := range op.query {
+			params = append(params, g.queryParams(reflect.TypeOf(query))...)
+		}
+
+		operation := map[string]interface{}{
+			"tags":    []string{op.tag},
+			"summary": op.summary,
+		}
+		if len(params) > 0 {
+			operation["parameters"] = params
+		}
+
+		if op.body != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  g.content([]string{fiber.MIMEApplicationJSON}, g.schema(reflect.TypeOf(op.body))),
+			}
+		} else if len(op.bodyTypes) > 0 {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  g.content(op.bodyTypes, map[string]interface{}{"type": "string", "format": "binary"}),
+			}
+		}
+
+		success := map[string]interface{}{"description": http.StatusText(op.status)}
+		if op.response != nil {
+			success["content"] = g.content([]string{fiber.MIMEApplicationJSON}, g.schema(reflect.TypeOf(op.response)))
+		} else if len(op.responseTypes) > 0 {
+			success["content"] = g.content(op.responseTypes, map[string]interface{}{"type": "string", "format": "binary"})
+		}
+		operation["responses"] = map[string]interface{}{
+			strconv.Itoa(op.status): success,
+			"default": map[string]interface{}{
+				"description": "Error",
+				"content":     g.content([]string{fiber.MIMEApplicationJSON}, g.schema(reflect.TypeOf(response{}))),
+			},
+		}
+
+		if paths[path] == nil {
+			paths[path] = map[string]interface{}{}
+		}
+		paths[path][strings.ToLower(op.method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "test-thai API",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": g.components,
+		},
+	}
+}
+
+// schemaGenerator generates JSON schemas of go types,
+// named structs are put to components and referenced.
+type schemaGenerator struct {
+	components map[string]interface{}
+}
+
+var (
+	_timeType    = reflect.TypeOf(time.Time{})
+	_decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+func (g *schemaGenerator) content(contentTypes []string, schema map[string]interface{}) map[string]interface{} {
+	content := map[string]interface{}{}
+	for _, contentType := range contentTypes {
+		content[contentType] = map[string]interface{}{"schema": schema}
+	}
+
+	return content
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) map[string]interface{} {
+	if t == _timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	// decimals are exact, so they are encoded as strings, e.g. "33.33"
+	if t == _decimalType {
+		return map[string]interface{}{"type": "string", "format": "decimal"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(g.schema(t.Elem()))
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		// patch field is documented as its value, absent fields are left unchanged
+		if strings.HasPrefix(t.Name(), "patchField[") {
+			value, _ := t.FieldByName("Value")
+			return g.schema(value.Type)
+		}
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		if _, ok := g.components[t.Name()]; !ok {
+			g.components[t.Name()] = nil // reserve the name for recursive types
+			g.components[t.Name()] = g.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// fields of embedded structs are encoded as the fields of the struct
+		if name == "" && f.Anonymous {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range g.structSchema(ft)["properties"].(map[string]interface{}) {
+					properties[k] = v
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = g.schema(f.Type)
+	}
+
+	return map[string]interface{}{"type": "object", "properties": properties}
+}
+
+// queryParams generates query params of the struct fields with query tags.
+func (g *schemaGenerator) queryParams(t reflect.Type) []interface{} {
+	var params []interface{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("query")
+		if name == "" || name == "-" {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		params = append(params, map[string]interface{}{
+			"name": name, "in": "query", "schema": g.schema(ft),
+		})
+	}
+
+	return params
+}
+
+// nullable marks the schema as nullable, refs can not have siblings in OpenAPI 3.0.
+func nullable(schema map[string]interface{}) map[string]interface{} {
+	if _, ok := schema["$ref"]; ok {
+		return map[string]interface{}{"allOf": []interface{}{schema}, "nullable": true}
+	}
+
+	s := make(map[string]interface{}, len(schema)+1)
+	for k, v := range schema {
+		s[k] = v
+	}
+	s["nullable"] = true
+
+	return s
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+// newTestApp registers the routes, handlers are not called, so services are not needed.
+func newTestApp() *fiber.App {
+	app := fiber.New()
+	New(app, logger.NewZerolog("error"), &service.Service{})
+
+	return app
+}
+
+func TestOpenAPIDocumentHasAllRoutes(t *testing.T) {
+	paths := openAPIDocument(_apiOperations)["paths"].(map[string]map[string]interface{})
+
+	for _, route := range newTestApp().GetRoutes(true) {
+		// fiber adds HEAD route for every GET route
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+		path, _ := openAPIPath(route.Path)
+		if _, ok := paths[path][strings.ToLower(route.Method)]; !ok {
+			t.Errorf("route %s %s is missing from the OpenAPI document", route.Method, route.Path)
+		}
+	}
+}
+
+func TestOpenAPIDocumentHasNoStaleOperations(t *testing.T) {
+	routes := map[string]bool{}
+	for _, route := range newTestApp().GetRoutes(true) {
+		path, _ := openAPIPath(route.Path)
+		routes[route.Method+" "+path] = true
+	}
+
+	for _, op := range _apiOperations {
+		path, _ := openAPIPath(op.path)
+		if !routes[op.method+" "+path] {
+			t.Errorf("operation %s %s of the OpenAPI document is not registered", op.method, op.path)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type pageParams struct {
+	Limit  int    `query:"limit"`
+	Cursor string `query:"cursor"`
+}
+
+func (p pageParams) page() *model.Page {
+	return &model.Page{
+		Limit:  p.Limit,
+		Cursor: p.Cursor,
+	}
+}
+
+// parseOrderBy parses order_by query param like "price:desc,item_name".
+// direction is asc if omitted.
+func parseOrderBy(orderBy string) ([]model.Order, error) {
+	if orderBy == "" {
+		return nil, nil
+	}
+
+	var orders []model.Order
+	for _, key := range strings.Split(orderBy, ",") {
+		field, dir, _ := strings.Cut(strings.TrimSpace(key), ":")
+		order := model.Order{Field: field}
+		switch strings.ToLower(dir) {
+		case "", "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return nil, fmt.Errorf("invalid order direction %q", dir)
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// searchQuery returns q search param, nil if it is blank.
+func searchQuery(q string) *string {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return nil
+	}
+
+	return &q
+}
+
+// tagsQuery returns tag names of comma separated tags param, nil if it is empty.
+func tagsQuery(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+
+	return strings.Split(tags, ",")
+}
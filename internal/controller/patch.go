@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// patchField is a field of JSON Merge Patch (RFC 7386) request body.
+// It tells apart the absent field, which is left unchanged, from the null one, which removes the value.
+type patchField[T any] struct {
+	Set   bool // field is present in the body
+	Null  bool // field is null
+	Value T
+}
+
+func (f *patchField[T]) UnmarshalJSON(b []byte) error {
+	f.Set = true
+	if string(b) == "null" {
+		f.Null = true
+		return nil
+	}
+
+	return json.Unmarshal(b, &f.Value)
+}
+
+// get returns pointer to the field value, nil if the field is absent.
+// null is an error, because none of the patched columns is nullable.
+func (f patchField[T]) get(name string) (*T, error) {
+	if !f.Set {
+		return nil, nil
+	}
+	if f.Null {
+		return nil, fmt.Errorf("%s can not be null", name)
+	}
+
+	return &f.Value, nil
+}
@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type promotionController struct {
+	s service.Promotion
+	l logger.Logger
+}
+
+func newPromotionController(router fiber.Router, l logger.Logger, promotionService service.Promotion) {
+	c := &promotionController{
+		s: promotionService,
+		l: l,
+	}
+
+	r := router.Group("/promotion")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+}
+
+// promotionRequest is the promotion of create and update.
+type promotionRequest struct {
+	PromotionName string     `json:"promotion_name"`
+	DiscountType  string     `json:"discount_type"`  // percent, fixed_amount or buy_x_get_y
+	DiscountValue float64    `json:"discount_value"` // percent or amount in the currency, 0 for buy_x_get_y
+	Currency      string     `json:"currency"`       // of the fixed_amount discount value, default currency if empty
+	BuyQuantity   int        `json:"buy_quantity"`   // buy_x_get_y only
+	GetQuantity   int        `json:"get_quantity"`   // buy_x_get_y only
+	TargetType    string     `json:"target_type"`    // item, category or group
+	TargetID      int        `json:"target_id"`
+	StartsAt      *time.Time `json:"starts_at"` // RFC 3339 time, open if empty
+	EndsAt        *time.Time `json:"ends_at"`   // RFC 3339 time, exclusive, open if empty
+	Weekdays      []int      `json:"weekdays"`  // 0 is Sunday, every day if empty
+	Stackable     bool       `json:"stackable"` // combined with other stackable promotions
+	Priority      int        `json:"priority"`  // stackable promotions are applied in ascending priority
+}
+
+func (r promotionRequest) promotion() *model.Promotion {
+	return &model.Promotion{
+		PromotionName: r.PromotionName,
+		DiscountType:  r.DiscountType,
+		DiscountValue: r.DiscountValue,
+		Currency:      r.Currency,
+		BuyQuantity:   r.BuyQuantity,
+		GetQuantity:   r.GetQuantity,
+		TargetType:    r.TargetType,
+		TargetID:      r.TargetID,
+		StartsAt:      r.StartsAt,
+		EndsAt:        r.EndsAt,
+		Weekdays:      r.Weekdays,
+		Stackable:     r.Stackable,
+		Priority:      r.Priority,
+	}
+}
+
+type promotionFilterParams struct {
+	TargetType *string `query:"target_type"`
+	TargetID   *int    `query:"target_id"`
+}
+
+func (c *promotionController) create(ctx *fiber.Ctx) error {
+	var req promotionRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.promotion())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create promotion error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *promotionController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get promotion id param error")
+		return errorResponse(ctx, 400, "get promotion id param error")
+	}
+
+	promotion, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get promotion error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(promotion)
+}
+
+func (c *promotionController) getAll(ctx *fiber.Ctx) error {
+	var (
+		params     promotionFilterParams
+		pageParams pageParams
+	)
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+	if err := ctx.QueryParser(&pageParams); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	promotions, pageInfo, myerr := c.s.GetAll(ctx.Context(), &model.PromotionFilter{
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+	}, pageParams.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all promotions error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"promotions":  promotions,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
+
+func (c *promotionController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get promotion id param error")
+		return errorResponse(ctx, 400, "get promotion id param error")
+	}
+
+	var req promotionRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.promotion())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update promotion error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *promotionController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get promotion id param error")
+		return errorResponse(ctx, 400, "get promotion id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete promotion error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type stockController struct {
+	s service.Stock
+	l logger.Logger
+}
+
+func newStockController(router fiber.Router, l logger.Logger, stockService service.Stock) {
+	c := &stockController{
+		s: stockService,
+		l: l,
+	}
+
+	router.Get("/stock", c.balances)
+	router.Get("/item-detail/:id/stock", c.balance)
+	router.Post("/item-detail/:id/stock/movements", c.createMovement)
+	router.Get("/item-detail/:id/stock/movements", c.movements)
+}
+
+// stockMovementRequest is the movement of the item detail.
+// Quantity of receive, sell and waste is positive, of adjust it is the signed correction.
+type stockMovementRequest struct {
+	Type     string  `json:"type"` // receive, sell, adjust or waste
+	Quantity float64 `json:"quantity"`
+	Note     string  `json:"note"`
+}
+
+func (c *stockController) createMovement(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var req stockMovementRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	movement, myerr := c.s.CreateMovement(ctx.Context(), &model.StockMovement{
+		ItemDetailID: id,
+		Type:         req.Type,
+		Quantity:     req.Quantity,
+		Note:         req.Note,
+	})
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create stock movement error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id":            movement.ID,
+		"balance_after": movement.BalanceAfter,
+	})
+}
+
+func (c *stockController) movements(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	movements, pageInfo, myerr := c.s.Movements(ctx.Context(), id, params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock movements error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"movements":   movements,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
+
+func (c *stockController) balance(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item detail id param error")
+		return errorResponse(ctx, 400, "get item detail id param error")
+	}
+
+	balance, myerr := c.s.Balance(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock balance error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(balance)
+}
+
+func (c *stockController) balances(ctx *fiber.Ctx) error {
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	balances, pageInfo, myerr := c.s.Balances(ctx.Context(), params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get stock balances error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"balances":    balances,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
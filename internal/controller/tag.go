@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type tagController struct {
+	s service.Tag
+	l logger.Logger
+}
+
+func newTagController(router fiber.Router, l logger.Logger, tagService service.Tag) {
+	c := &tagController{
+		s: tagService,
+		l: l,
+	}
+
+	r := router.Group("/tag")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+
+	router.Post("/item/:id/tags", c.attachToItem)
+	router.Delete("/item/:id/tags/:tagId", c.detachFromItem)
+}
+
+// tagRequest is the tag of create and update, the name is trimmed and lowercased.
+type tagRequest struct {
+	TagName string `json:"tag_name"`
+}
+
+// tagAttachRequest adds the tag to the item.
+type tagAttachRequest struct {
+	TagID int `json:"tag_id"`
+}
+
+func (c *tagController) create(ctx *fiber.Ctx) error {
+	var req tagRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.TagName)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create tag error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *tagController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tag id param error")
+		return errorResponse(ctx, 400, "get tag id param error")
+	}
+
+	tag, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get tag error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(tag)
+}
+
+func (c *tagController) getAll(ctx *fiber.Ctx) error {
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	tags, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all tags error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"tags":        tags,
+		"next_cursor": pageInfo.NextCursor,
+		"total":       pageInfo.Total,
+	})
+}
+
+func (c *tagController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tag id param error")
+		return errorResponse(ctx, 400, "get tag id param error")
+	}
+
+	var req tagRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.TagName)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update tag error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *tagController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tag id param error")
+		return errorResponse(ctx, 400, "get tag id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete tag error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *tagController) attachToItem(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+
+	var req tagAttachRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.AttachToItem(ctx.Context(), id, req.TagID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "add tag to item error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *tagController) detachFromItem(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get item id param error")
+		return errorResponse(ctx, 400, "get item id param error")
+	}
+	tagID, err := ctx.ParamsInt("tagId")
+	if err != nil {
+		c.l.Error(err, "get tag id param error")
+		return errorResponse(ctx, 400, "get tag id param error")
+	}
+
+	myerr := c.s.DetachFromItem(ctx.Context(), id, tagID)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "remove tag from item error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+	"github.com/shopspring/decimal"
+)
+
+type taxProfileController struct {
+	s service.TaxProfile
+	l logger.Logger
+}
+
+func newTaxProfileController(router fiber.Router, l logger.Logger, taxProfileService service.TaxProfile) {
+	c := &taxProfileController{
+		s: taxProfileService,
+		l: l,
+	}
+
+	r := router.Group("/tax-profile")
+
+	r.Post("/", c.create)
+	r.Get("/:id", c.get)
+	r.Get("/", c.getAll)
+	r.Put("/:id", c.update)
+	r.Delete("/:id", c.delete)
+}
+
+// taxProfileRequest is the tax profile of create and update.
+type taxProfileRequest struct {
+	ProfileName          string          `json:"profile_name"`
+	VATPercent           decimal.Decimal `json:"vat_percent"`
+	ServiceChargePercent decimal.Decimal `json:"service_charge_percent"`
+	PriceIncludesTax     bool            `json:"price_includes_tax"` // price is gross if true, net otherwise
+}
+
+func (r taxProfileRequest) taxProfile() *model.TaxProfile {
+	return &model.TaxProfile{
+		ProfileName:          r.ProfileName,
+		VATPercent:           r.VATPercent,
+		ServiceChargePercent: r.ServiceChargePercent,
+		PriceIncludesTax:     r.PriceIncludesTax,
+	}
+}
+
+func (c *taxProfileController) create(ctx *fiber.Ctx) error {
+	var req taxProfileRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	id, myerr := c.s.Create(ctx.Context(), req.taxProfile())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "create tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id": id,
+	})
+}
+
+func (c *taxProfileController) get(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tax profile id param error")
+		return errorResponse(ctx, 400, "get tax profile id param error")
+	}
+
+	profile, myerr := c.s.Get(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(profile)
+}
+
+func (c *taxProfileController) getAll(ctx *fiber.Ctx) error {
+	var params pageParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		c.l.Error(err, "query parser error")
+		return errorResponse(ctx, 400, "query parser error")
+	}
+
+	profiles, pageInfo, myerr := c.s.GetAll(ctx.Context(), params.page())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "get all tax profiles error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"tax_profiles": profiles,
+		"next_cursor":  pageInfo.NextCursor,
+		"total":        pageInfo.Total,
+	})
+}
+
+func (c *taxProfileController) update(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tax profile id param error")
+		return errorResponse(ctx, 400, "get tax profile id param error")
+	}
+
+	var req taxProfileRequest
+
+	if err := ctx.BodyParser(&req); err != nil {
+		c.l.Error(err, "body parser error")
+		return errorResponse(ctx, 400, "body parser error")
+	}
+
+	myerr := c.s.Update(ctx.Context(), id, req.taxProfile())
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "update tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+func (c *taxProfileController) delete(ctx *fiber.Ctx) error {
+	id, err := ctx.ParamsInt("id")
+	if err != nil {
+		c.l.Error(err, "get tax profile id param error")
+		return errorResponse(ctx, 400, "get tax profile id param error")
+	}
+
+	myerr := c.s.Delete(ctx.Context(), id)
+	if myerr.IsErr() {
+		c.l.Error(myerr.Err, "delete tax profile error")
+		return errorResponse(ctx, myerr.Code, myerr.Message)
+	}
+
+	return ctx.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/service"
+	"github.com/lmnq/test-thai/logger"
+)
+
+type translationController struct {
+	s service.Translation
+	l logger.Logger
+}
+
+func newTranslationController(router fiber.Router, l logger.Logger, translationService service.Translation) {
+	c := &translationController{
+		s: translationService,
+		l: l,
+	}
+
+	c.routes(router.Group("/item"), model.TranslationItem)
+	c.routes(router.Group("/category"), model.TranslationCategory)
+	c.routes(router.Group("/group"), model.TranslationGroup)
+}
+
+// routes registers translation routes of the entity.
+func (c *translationController) routes(r fiber.Router, entity string) {
+	r.Get("/:id/translations", c.getAll(entity))
+	r.Put("/:id/translations/:locale", c.set(entity))
+	r.Delete("/:id/translations/:locale", c.delete(entity))
+}
+
+// localeParams selects the locale of item, category and group names, Accept-Language header is used if it is empty.
+// Names without translation to the locale are in the default locale.
+type localeParams struct {
+	Lang string `query:"lang"`
+}
+
+// selectLocale selects the locale of the names of the response by the params or Accept-Language header.
+func selectLocale(ctx *fiber.Ctx, translation service.Translation) (string, errs.Error) {
+	var params localeParams
+
+	if err := ctx.QueryParser(&params); err != nil {
+		return "", errs.Error{
+			Err:     err,
+			Code:    400,
+			Message: "query parser error",
+		}
+	}
+
+	locale, myerr := translation.Locale(params.Lang, ctx.Get(fiber.HeaderAcceptLanguage))
+	if myerr.IsErr() {
+		return "", myerr
+	}
+
+	ctx.Set(fiber.HeaderContentLanguage, locale)
+	ctx.Vary(fiber.HeaderAcceptLanguage)
+
+	return locale, errs.NilError()
+}
+
+func (c *translationController) getAll(entity string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		id, err := ctx.ParamsInt("id")
+		if err != nil {
+			c.l.Error(err, "get "+entity+" id param error")
+			return errorResponse(ctx, 400, "get "+entity+" id param error")
+		}
+
+		translations, myerr := c.s.GetAll(ctx.Context(), entity, id)
+		if myerr.IsErr() {
+			c.l.Error(myerr.Err, "get all "+entity+" translations error")
+			return errorResponse(ctx, myerr.Code, myerr.Message)
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+			"translations": translations,
+		})
+	}
+}
+
+type translationRequest struct {
+	Name string `json:"name"`
+}
+
+func (c *translationController) set(entity string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		id, err := ctx.ParamsInt("id")
+		if err != nil {
+			c.l.Error(err, "get "+entity+" id param error")
+			return errorResponse(ctx, 400, "get "+entity+" id param error")
+		}
+
+		var req translationRequest
+
+		if err := ctx.BodyParser(&req); err != nil {
+			c.l.Error(err, "body parser error")
+			return errorResponse(ctx, 400, "body parser error")
+		}
+
+		myerr := c.s.Set(ctx.Context(), entity, id, &model.Translation{
+			Locale: ctx.Params("locale"),
+			Name:   req.Name,
+		})
+		if myerr.IsErr() {
+			c.l.Error(myerr.Err, "set "+entity+" translation error")
+			return errorResponse(ctx, myerr.Code, myerr.Message)
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
+
+func (c *translationController) delete(entity string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		id, err := ctx.ParamsInt("id")
+		if err != nil {
+			c.l.Error(err, "get "+entity+" id param error")
+			return errorResponse(ctx, 400, "get "+entity+" id param error")
+		}
+
+		myerr := c.s.Delete(ctx.Context(), entity, id, ctx.Params("locale"))
+		if myerr.IsErr() {
+			c.l.Error(myerr.Err, "delete "+entity+" translation error")
+			return errorResponse(ctx, myerr.Code, myerr.Message)
+		}
+
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+}
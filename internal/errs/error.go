@@ -5,6 +5,12 @@ import "errors"
 var (
 	ErrNotFound          = errors.New("not found")
 	ErrUniqueConstraint  = errors.New("unique constraint error")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrVariantExists     = errors.New("variant name already exists")
+	ErrSKUExists         = errors.New("sku already exists")
+	ErrBarcodeExists     = errors.New("barcode already exists")
+	ErrCategoryCycle     = errors.New("category parent is its descendant")
+	ErrNegativeStock     = errors.New("not enough stock")
 	UniqueConstraintCode = "23505"
 
 	// status code error messages
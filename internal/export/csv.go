@@ -0,0 +1,37 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// utf-8 BOM makes Excel open the csv as utf-8, so Thai text is not garbled
+const _utf8BOM = "\ufeff"
+
+type csvWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+// NewCSV returns csv writer. It writes utf-8 BOM first.
+func NewCSV(w io.Writer) (Writer, error) {
+	if _, err := io.WriteString(w, _utf8BOM); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(row []interface{}) error {
+	c.record = c.record[:0]
+	for _, value := range row {
+		c.record = append(c.record, formatValue(value))
+	}
+
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
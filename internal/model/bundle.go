@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// Bundle is a set of item details sold for one price, e.g. a set menu of pad thai, a drink and a dessert.
+// Cost is rolled up from the components, Price is ManualPrice if it is set, else ComputedPrice.
+// Unavailable is set while any component item detail is deleted.
+type Bundle struct {
+	ID            int                `json:"id"`
+	BundleName    string             `json:"bundle_name"`
+	Currency      string             `json:"currency"`       // ISO 4217 code of cost and prices, component amounts are converted to it
+	ManualPrice   *float64           `json:"manual_price"`   // nil if the price is computed
+	ComputedPrice float64            `json:"computed_price"` // sum of component price * quantity
+	Price         float64            `json:"price"`
+	Cost          float64            `json:"cost"` // sum of component cost * quantity
+	Unavailable   bool               `json:"unavailable"`
+	Components    []*BundleComponent `json:"components"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	DeletedAt     *time.Time         `json:"deleted_at"`
+}
+
+// BundleComponent is the quantity of the item detail in the bundle.
+// Cost and Price are the unit amounts of the item detail in its currency.
+type BundleComponent struct {
+	ItemDetailID int     `json:"item_detail_id"`
+	ItemID       int     `json:"item_id"`
+	ItemName     string  `json:"item_name"`
+	VariantName  *string `json:"variant_name"`
+	Quantity     int     `json:"quantity"`
+	Cost         float64 `json:"cost"`
+	Price        float64 `json:"price"`
+	Currency     string  `json:"currency"`
+	Deleted      bool    `json:"deleted"` // item detail is deleted, the bundle is unavailable
+}
+
+// BundleFilter selects bundles, nil fields are not filtered.
+type BundleFilter struct {
+	Unavailable *bool `json:"unavailable"`
+}
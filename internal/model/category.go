@@ -5,7 +5,15 @@ import "time"
 type Category struct {
 	ID           int        `json:"id"`
 	CategoryName string     `json:"category_name"`
+	ParentID     *int       `json:"parent_id"`      // nil if the category is top level
+	TaxProfileID *int       `json:"tax_profile_id"` // nil if the category has no tax profile
 	CreatedAt    time.Time  `json:"created_at"`
 	UpdatedAt    time.Time  `json:"updated_at"`
 	DeletedAt    *time.Time `json:"deleted_at"`
 }
+
+// CategoryNode is the category with its sub-categories in the category tree.
+type CategoryNode struct {
+	*Category
+	Children []*CategoryNode `json:"children"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// ExchangeRate is the price in QuoteCurrency of one unit of BaseCurrency.
+type ExchangeRate struct {
+	ID            int        `json:"id"`
+	BaseCurrency  string     `json:"base_currency"`
+	QuoteCurrency string     `json:"quote_currency"`
+	Rate          float64    `json:"rate"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+}
+
+// Rounding of the converted prices.
+type Rounding struct {
+	Mode     string // one of RoundingModes
+	Decimals int    // decimal places, negative rounds to tens, hundreds, etc.
+}
+
+// rounding modes
+const (
+	RoundingHalfUp   = "half_up"   // half away from zero
+	RoundingHalfEven = "half_even" // half to even, banker's rounding
+	RoundingCeil     = "ceil"
+	RoundingFloor    = "floor"
+)
+
+var RoundingModes = []string{
+	RoundingHalfUp,
+	RoundingHalfEven,
+	RoundingCeil,
+	RoundingFloor,
+}
@@ -3,9 +3,10 @@ package model
 import "time"
 
 type Group struct {
-	ID        int       `json:"id"`
-	GroupName string    `json:"group_name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at"`
+	ID           int        `json:"id"`
+	GroupName    string     `json:"group_name"`
+	TaxProfileID *int       `json:"tax_profile_id"` // nil if the group has no tax profile
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type ItemImage struct {
+	ID           int       `json:"id"`
+	ItemID       int       `json:"item_id"`
+	ContentType  string    `json:"content_type"` // image/jpeg, image/png or image/webp
+	Size         int64     `json:"size"`         // bytes
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"` // JPEG thumbnail
+	Key          string    `json:"-"`             // storage key of the image
+	ThumbnailKey string    `json:"-"`             // storage key of the thumbnail
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ImageLink is the image of the item and item detail views.
+type ImageLink struct {
+	ID           int    `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// ThumbnailContentType is the content type of the image thumbnails.
+const ThumbnailContentType = "image/jpeg"
@@ -3,9 +3,18 @@ package model
 import "time"
 
 type Item struct {
-	ID        int       `json:"id"`
-	ItemName  string    `json:"item_name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at"`
+	ID        int          `json:"id"`
+	ItemName  string       `json:"item_name"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	DeletedAt *time.Time   `json:"deleted_at"`
+	Tags      []string     `json:"tags"`                // tag names ordered by name
+	Images    []*ImageLink `json:"images"`              // in upload order
+	Relevance *float64     `json:"relevance,omitempty"` // search rank, set only when searching by q
+}
+
+type ItemFilter struct {
+	Q        *string  `json:"q"`         // partial or fuzzy item name search
+	Tags     []string `json:"tags"`      // tag names
+	TagsMode string   `json:"tags_mode"` // TagsModeAny or TagsModeAll, any by default
 }
@@ -1,39 +1,233 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type ItemDetail struct {
-	ID         int        `json:"id"`
-	ItemID     int        `json:"item_id"`
-	CategoryID int        `json:"category_id"`
-	GroupID    int        `json:"group_id"`
-	Cost       float64    `json:"cost"`
-	Price      float64    `json:"price"`
-	Sort       int        `json:"sort"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
-	DeletedAt  *time.Time `json:"deleted_at"`
+	ID          int        `json:"id"`
+	ItemID      int        `json:"item_id"`
+	VariantName *string    `json:"variant_name"` // e.g. small or large, nil if the item detail is not a variant
+	SKU         *string    `json:"sku"`          // stock keeping unit, unique
+	Barcode     *string    `json:"barcode"`      // EAN-13 or UPC-A, unique
+	CategoryID  int        `json:"category_id"`
+	GroupID     int        `json:"group_id"`
+	Cost        float64    `json:"cost"`
+	Price       float64    `json:"price"`
+	Currency    string     `json:"currency"` // ISO 4217 code of cost and price
+	Sort        int        `json:"sort"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
 }
 
 type ItemDetailView struct {
-	ID           int        `json:"id"`
-	ItemID       int        `json:"item_id"`
-	ItemName     string     `json:"item_name"`
-	CategoryID   int        `json:"category_id"`
-	CategoryName string     `json:"category_name"`
-	GroupID      int        `json:"group_id"`
-	GroupName    string     `json:"group_name"`
-	Cost         float64    `json:"cost"`
-	Price        float64    `json:"price"`
-	Sort         int        `json:"sort"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-	DeletedAt    *time.Time `json:"deleted_at"`
+	ID             int              `json:"id"`
+	ItemID         int              `json:"item_id"`
+	ItemName       string           `json:"item_name"`
+	VariantName    *string          `json:"variant_name"`
+	SKU            *string          `json:"sku"`
+	Barcode        *string          `json:"barcode"`
+	CategoryID     int              `json:"category_id"`
+	CategoryName   string           `json:"category_name"`
+	GroupID        int              `json:"group_id"`
+	GroupName      string           `json:"group_name"`
+	Tags           []string         `json:"tags"`   // tag names of the item ordered by name
+	Images         []*ImageLink     `json:"images"` // images of the item in upload order
+	Cost           decimal.Decimal  `json:"cost"`
+	Price          decimal.Decimal  `json:"price"`
+	Margin         decimal.Decimal  `json:"margin"`                    // price - cost
+	MarginPercent  *decimal.Decimal `json:"margin_percent"`            // margin of the price, nil if price is 0
+	MarkupPercent  *decimal.Decimal `json:"markup_percent"`            // margin of the cost, nil if cost is 0
+	Currency       string           `json:"currency"`                  // ISO 4217 code of cost, price, margin and tax amounts
+	TaxProfile     *TaxProfile      `json:"tax_profile"`               // profile of the category, else of the group
+	NetPrice       decimal.Decimal  `json:"net_price"`                 // price without service charge and VAT
+	ServiceCharge  decimal.Decimal  `json:"service_charge"`            // service charge on the net price
+	VAT            decimal.Decimal  `json:"vat"`                       // VAT on the net price with the service charge
+	TaxAmount      decimal.Decimal  `json:"tax_amount"`                // service charge + VAT
+	GrossPrice     decimal.Decimal  `json:"gross_price"`               // net price + tax amount, the price if there is no tax profile
+	ModifierGroups []*ModifierGroup `json:"modifier_groups,omitempty"` // of the item detail and its category, set only when getting by id or barcode
+	Sort           int              `json:"sort"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	DeletedAt      *time.Time       `json:"deleted_at"`
+	Relevance      *float64         `json:"relevance,omitempty"` // search rank, set only when searching by q
+}
+
+// ItemVariants is the item with its item details grouped together, variants are in the list order.
+type ItemVariants struct {
+	ItemID   int               `json:"item_id"`
+	ItemName string            `json:"item_name"`
+	Variants []*ItemDetailView `json:"variants"`
 }
 
 type ItemDetailFilter struct {
-	ID           *int    `json:"id"`
-	ItemName     *string `json:"item_name"`
-	CategoryName *string `json:"category_name"`
-	GroupName    *string `json:"group_name"`
+	ID                   *int             `json:"id"`
+	Q                    *string          `json:"q"` // partial or fuzzy item, category and group name search in any locale
+	ItemName             *string          `json:"item_name"`
+	CategoryID           *int             `json:"category_id"`
+	CategoryName         *string          `json:"category_name"`
+	IncludeSubcategories bool             `json:"include_subcategories"` // category id and name filters match the sub-categories too
+	GroupName            *string          `json:"group_name"`
+	Tags                 []string         `json:"tags"`      // tag names of the item
+	TagsMode             string           `json:"tags_mode"` // TagsModeAny or TagsModeAll, any by default
+	MinMarginPercent     *decimal.Decimal `json:"min_margin_percent"`
+	MaxMarginPercent     *decimal.Decimal `json:"max_margin_percent"`
+	OrderBy              []Order          `json:"order_by"`
+}
+
+// item detail list order fields
+const (
+	ItemDetailOrderSort         = "sort"
+	ItemDetailOrderPrice        = "price"
+	ItemDetailOrderCost         = "cost"
+	ItemDetailOrderItemName     = "item_name"
+	ItemDetailOrderCategoryName = "category_name"
+	ItemDetailOrderGroupName    = "group_name"
+	ItemDetailOrderCreatedAt    = "created_at"
+	ItemDetailOrderUpdatedAt    = "updated_at"
+)
+
+// ItemDetailOrderFields is a whitelist of the item detail list order fields.
+var ItemDetailOrderFields = []string{
+	ItemDetailOrderSort,
+	ItemDetailOrderPrice,
+	ItemDetailOrderCost,
+	ItemDetailOrderItemName,
+	ItemDetailOrderCategoryName,
+	ItemDetailOrderGroupName,
+	ItemDetailOrderCreatedAt,
+	ItemDetailOrderUpdatedAt,
+}
+
+// ItemDetailPatch is a partial item detail update, nil fields are left unchanged.
+type ItemDetailPatch struct {
+	ItemName    *string  `json:"item_name"`
+	VariantName *string  `json:"variant_name"` // empty makes the item detail not a variant
+	SKU         *string  `json:"sku"`          // empty removes the sku
+	Barcode     *string  `json:"barcode"`      // empty removes the barcode
+	GroupID     *int     `json:"group_id"`
+	CategoryID  *int     `json:"category_id"`
+	Cost        *float64 `json:"cost"`
+	Price       *float64 `json:"price"`
+	Currency    *string  `json:"currency"`
+	Sort        *int     `json:"sort"`
+}
+
+// bulk operations
+const (
+	BulkOpCreate = "create"
+	BulkOpUpdate = "update"
+	BulkOpDelete = "delete"
+)
+
+// bulk modes
+const (
+	BulkModeAllOrNothing = "all_or_nothing" // any failed operation rolls back all of them
+	BulkModeBestEffort   = "best_effort"    // failed operations are skipped, the others are applied
+)
+
+// bulk operation result statuses
+const (
+	BulkStatusApplied    = "applied"
+	BulkStatusFailed     = "failed"
+	BulkStatusRolledBack = "rolled_back" // not applied because another operation failed in all_or_nothing mode
+)
+
+// ItemDetailBulkOp is an operation of the item detail bulk request.
+// ID is used by update and delete, ItemName and ItemDetail by create and update.
+type ItemDetailBulkOp struct {
+	Op         string
+	ID         int
+	ItemName   string
+	ItemDetail *ItemDetail
+}
+
+type ItemDetailBulkResult struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	ID     int    `json:"id,omitempty"`
+	Status string `json:"status"`
+	Code   int    `json:"code,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ItemDetailImportRow is a parsed row of the item detail import file.
+type ItemDetailImportRow struct {
+	Line         int
+	ItemName     string
+	CategoryName string
+	GroupName    string
+	Cost         float64
+	Price        float64
+	Currency     string
+	Sort         int
+}
+
+// import row actions
+const (
+	ImportActionCreate    = "create"
+	ImportActionUpdate    = "update"
+	ImportActionUnchanged = "unchanged"
+	ImportActionError     = "error"
+)
+
+type ItemDetailImportValues struct {
+	Cost     float64 `json:"cost"`
+	Price    float64 `json:"price"`
+	Currency string  `json:"currency"`
+	Sort     int     `json:"sort"`
+}
+
+// ItemDetailImportResult is a result of the import row.
+// Before is set for updates, After for creates and updates.
+type ItemDetailImportResult struct {
+	Line         int                     `json:"line"`
+	Action       string                  `json:"action"`
+	ItemDetailID int                     `json:"item_detail_id,omitempty"`
+	ItemName     string                  `json:"item_name"`
+	CategoryName string                  `json:"category_name"`
+	GroupName    string                  `json:"group_name"`
+	NewCategory  bool                    `json:"new_category,omitempty"`
+	NewGroup     bool                    `json:"new_group,omitempty"`
+	Before       *ItemDetailImportValues `json:"before,omitempty"`
+	After        *ItemDetailImportValues `json:"after,omitempty"`
+	Error        string                  `json:"error,omitempty"`
+}
+
+type ItemDetailImportReport struct {
+	DryRun    bool                      `json:"dry_run"`
+	Applied   bool                      `json:"applied"` // false if dry run or any row failed
+	Created   int                       `json:"created"`
+	Updated   int                       `json:"updated"`
+	Unchanged int                       `json:"unchanged"`
+	Failed    int                       `json:"failed"`
+	Rows      []*ItemDetailImportResult `json:"rows"`
+}
+
+// ItemDetailPrice is the cost and price of the item detail since ChangedAt until the next change.
+type ItemDetailPrice struct {
+	ItemDetailID int       `json:"item_detail_id"`
+	Cost         float64   `json:"cost"`
+	Price        float64   `json:"price"`
+	Currency     string    `json:"currency"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
+
+// PriceHistoryFilter selects price changes in [From, To), nil bound is open.
+type PriceHistoryFilter struct {
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+}
+
+// ItemDetailScheduledPrice is a cost and price change of the item detail applied at EffectiveFrom.
+type ItemDetailScheduledPrice struct {
+	ID            int       `json:"id"`
+	ItemDetailID  int       `json:"item_detail_id"`
+	Cost          float64   `json:"cost"`
+	Price         float64   `json:"price"`
+	EffectiveFrom time.Time `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
 }
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// ModifierGroup is a choice of the item detail options, e.g. spice level.
+// MinSelections to MaxSelections of its modifiers are selected with the item detail.
+type ModifierGroup struct {
+	ID                int         `json:"id"`
+	ModifierGroupName string      `json:"modifier_group_name"`
+	MinSelections     int         `json:"min_selections"`
+	MaxSelections     int         `json:"max_selections"`
+	Modifiers         []*Modifier `json:"modifiers"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+	DeletedAt         *time.Time  `json:"deleted_at"`
+}
+
+// Modifier is an option of the modifier group, e.g. extra egg.
+type Modifier struct {
+	ID              int        `json:"id"`
+	ModifierGroupID int        `json:"modifier_group_id"`
+	ModifierName    string     `json:"modifier_name"`
+	PriceDelta      float64    `json:"price_delta"` // added to the item detail price, in its currency
+	Sort            int        `json:"sort"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at"`
+}
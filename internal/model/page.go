@@ -0,0 +1,21 @@
+package model
+
+// Page is a keyset pagination request.
+// Cursor is the opaque next_cursor value from the previous page, empty for the first page.
+type Page struct {
+	Limit  int    `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// PageInfo is a pagination metadata of the list response.
+// NextCursor is nil on the last page.
+type PageInfo struct {
+	NextCursor *string `json:"next_cursor"`
+	Total      int     `json:"total"`
+}
+
+// Order is a list order key.
+type Order struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc"`
+}
@@ -0,0 +1,83 @@
+package model
+
+import "time"
+
+// promotion discount types
+const (
+	PromotionPercent     = "percent"      // discount_value percent off the unit price
+	PromotionFixedAmount = "fixed_amount" // discount_value off the unit price, in the promotion currency
+	PromotionBuyXGetY    = "buy_x_get_y"  // get_quantity free units of each buy_quantity + get_quantity units
+)
+
+// PromotionTypes is a whitelist of the promotion discount types.
+var PromotionTypes = []string{
+	PromotionPercent,
+	PromotionFixedAmount,
+	PromotionBuyXGetY,
+}
+
+// promotion target types
+const (
+	PromotionTargetItem     = "item"
+	PromotionTargetCategory = "category"
+	PromotionTargetGroup    = "group"
+)
+
+// PromotionTargets is a whitelist of the promotion target types.
+var PromotionTargets = []string{
+	PromotionTargetItem,
+	PromotionTargetCategory,
+	PromotionTargetGroup,
+}
+
+// Promotion is a discount of the item details of an item, a category or a group.
+// It is active in [StartsAt, EndsAt) on the Weekdays, nil bound is open and empty Weekdays is every day.
+// Stackable promotions are combined in ascending Priority, the others are applied alone,
+// the combination with the lowest total is used.
+type Promotion struct {
+	ID            int        `json:"id"`
+	PromotionName string     `json:"promotion_name"`
+	DiscountType  string     `json:"discount_type"`
+	DiscountValue float64    `json:"discount_value"` // percent or amount, 0 for buy_x_get_y
+	Currency      string     `json:"currency"`       // of the fixed_amount discount value
+	BuyQuantity   int        `json:"buy_quantity"`   // buy_x_get_y only
+	GetQuantity   int        `json:"get_quantity"`   // buy_x_get_y only
+	TargetType    string     `json:"target_type"`
+	TargetID      int        `json:"target_id"`
+	StartsAt      *time.Time `json:"starts_at"`
+	EndsAt        *time.Time `json:"ends_at"`
+	Weekdays      []int      `json:"weekdays"` // 0 is Sunday
+	Stackable     bool       `json:"stackable"`
+	Priority      int        `json:"priority"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+}
+
+// PromotionFilter selects promotions of the target, nil fields are not filtered.
+type PromotionFilter struct {
+	TargetType *string `json:"target_type"`
+	TargetID   *int    `json:"target_id"`
+}
+
+// AppliedPromotion is a promotion used for the promotional price and its discount of the total.
+type AppliedPromotion struct {
+	PromotionID   int     `json:"promotion_id"`
+	PromotionName string  `json:"promotion_name"`
+	DiscountType  string  `json:"discount_type"`
+	Discount      float64 `json:"discount"`
+}
+
+// PromotionalPrice is the price of the quantity of the item detail with the promotions active at At.
+type PromotionalPrice struct {
+	ItemDetailID      int                 `json:"item_detail_id"`
+	Quantity          int                 `json:"quantity"`
+	Currency          string              `json:"currency"`
+	At                time.Time           `json:"at"`
+	Price             float64             `json:"price"`              // regular unit price
+	Total             float64             `json:"total"`              // regular price of the quantity
+	Discount          float64             `json:"discount"`           // total - promotional total
+	PromotionalTotal  float64             `json:"promotional_total"`  // price of the quantity with the promotions
+	PromotionalPrice  float64             `json:"promotional_price"`  // promotional total per unit
+	AppliedPromotions []*AppliedPromotion `json:"applied_promotions"` // in the order of application
+}
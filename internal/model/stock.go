@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// StockMovement is a change of the on-hand quantity of the item detail.
+type StockMovement struct {
+	ID           int       `json:"id"`
+	ItemDetailID int       `json:"item_detail_id"`
+	Type         string    `json:"type"`          // receive, sell, adjust or waste
+	Quantity     float64   `json:"quantity"`      // signed change, sell and waste are negative
+	BalanceAfter float64   `json:"balance_after"` // on-hand quantity after the movement
+	Note         string    `json:"note"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// StockBalance is the on-hand quantity of the item detail, the balance after its last movement.
+type StockBalance struct {
+	ItemDetailID int        `json:"item_detail_id"`
+	Quantity     float64    `json:"quantity"`
+	UpdatedAt    *time.Time `json:"updated_at"` // time of the last movement, nil if there are no movements
+}
+
+// stock movement types
+const (
+	StockMovementReceive = "receive" // delivery from a supplier, adds the quantity
+	StockMovementSell    = "sell"    // sold, removes the quantity
+	StockMovementAdjust  = "adjust"  // stock count correction, signed quantity
+	StockMovementWaste   = "waste"   // spoiled or broken, removes the quantity
+)
+
+// StockMovementTypes is a whitelist of the stock movement types.
+var StockMovementTypes = []string{
+	StockMovementReceive,
+	StockMovementSell,
+	StockMovementAdjust,
+	StockMovementWaste,
+}
+
+// negative stock policies
+const (
+	StockNegativeAllow  = "allow"  // movements may take the balance below zero, e.g. sales before the delivery is booked
+	StockNegativeReject = "reject" // movements taking the balance below zero are rejected
+)
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type Tag struct {
+	ID        int        `json:"id"`
+	TagName   string     `json:"tag_name"` // lowercase, e.g. signature or chef recommends
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+// tag filter modes
+const (
+	TagsModeAny = "any" // item has any of the tags
+	TagsModeAll = "all" // item has all of the tags
+)
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TaxProfile is the VAT and service charge of the item details of a category or a group.
+// Service charge is on the net price, VAT is on the net price with the service charge.
+type TaxProfile struct {
+	ID                   int             `json:"id"`
+	ProfileName          string          `json:"profile_name"`
+	VATPercent           decimal.Decimal `json:"vat_percent"`
+	ServiceChargePercent decimal.Decimal `json:"service_charge_percent"`
+	PriceIncludesTax     bool            `json:"price_includes_tax"` // price is gross if true, net otherwise
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+	DeletedAt            *time.Time      `json:"deleted_at"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Translation is the name of an item, category or group in the locale.
+type Translation struct {
+	Locale    string    `json:"locale"` // ISO 639-1 language code, e.g. en
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// translated entities
+const (
+	TranslationItem     = "item"
+	TranslationCategory = "category"
+	TranslationGroup    = "group"
+)
@@ -0,0 +1,332 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type BundleRepo struct {
+	*postgres.Postgres
+}
+
+func NewBundleRepo(pg *postgres.Postgres) *BundleRepo {
+	return &BundleRepo{pg}
+}
+
+const (
+	// bundle is unavailable while any of its component item details is deleted
+	_bundleUnavailableExpr = `EXISTS (
+				SELECT 1
+				FROM tbl_bundle_items AS bi
+				JOIN tbl_item_details AS itd ON itd.id = bi.item_detail_id
+				WHERE bi.bundle_id = b.id
+				AND itd.deleted_at IS NOT NULL
+			)`
+	_bundleColumns = `
+			b.id,
+			b.bundle_name,
+			b.currency,
+			b.price::float8,
+			` + _bundleUnavailableExpr + `,
+			b.created_at,
+			b.updated_at,
+			b.deleted_at
+`
+)
+
+func scanBundle(row pgx.Row) (*model.Bundle, error) {
+	bundle := model.Bundle{Components: []*model.BundleComponent{}}
+	err := row.Scan(
+		&bundle.ID,
+		&bundle.BundleName,
+		&bundle.Currency,
+		&bundle.ManualPrice,
+		&bundle.Unavailable,
+		&bundle.CreatedAt,
+		&bundle.UpdatedAt,
+		&bundle.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// queryBundles returns bundles of the query with their components.
+func (r *BundleRepo) queryBundles(ctx context.Context, q string, args ...interface{}) ([]*model.Bundle, error) {
+	rows, err := r.Pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bundles []*model.Bundle
+	for rows.Next() {
+		bundle, err := scanBundle(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		bundles = append(bundles, bundle)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadComponents(ctx, bundles); err != nil {
+		return nil, err
+	}
+
+	return bundles, nil
+}
+
+// loadComponents sets components of the bundles in one query, deleted item details included.
+func (r *BundleRepo) loadComponents(ctx context.Context, bundles []*model.Bundle) error {
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	bundleByID := make(map[int]*model.Bundle, len(bundles))
+	ids := make([]int, 0, len(bundles))
+	for _, bundle := range bundles {
+		bundleByID[bundle.ID] = bundle
+		ids = append(ids, bundle.ID)
+	}
+
+	q := `SELECT
+			bi.bundle_id,
+			bi.item_detail_id,
+			itd.item_id,
+			i.item_name,
+			itd.variant_name,
+			bi.quantity,
+			itd.cost::float8,
+			itd.price::float8,
+			itd.currency,
+			itd.deleted_at IS NOT NULL
+		FROM tbl_bundle_items AS bi
+		JOIN tbl_item_details AS itd ON itd.id = bi.item_detail_id
+		JOIN tbl_items AS i ON i.id = itd.item_id
+		WHERE bi.bundle_id = ANY($1)
+		ORDER BY itd.sort, bi.item_detail_id
+	`
+	rows, err := r.Pool.Query(ctx, q, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bundleID  int
+			component model.BundleComponent
+		)
+		err := rows.Scan(
+			&bundleID,
+			&component.ItemDetailID,
+			&component.ItemID,
+			&component.ItemName,
+			&component.VariantName,
+			&component.Quantity,
+			&component.Cost,
+			&component.Price,
+			&component.Currency,
+			&component.Deleted,
+		)
+		if err != nil {
+			return err
+		}
+
+		bundle := bundleByID[bundleID]
+		bundle.Components = append(bundle.Components, &component)
+	}
+
+	return rows.Err()
+}
+
+// insertComponents inserts components of the bundle on the transaction conn.
+func insertComponents(ctx context.Context, conn postgres.Connection, bundleID int, components []*model.BundleComponent) error {
+	q := `INSERT INTO tbl_bundle_items (bundle_id, item_detail_id, quantity) VALUES ($1, $2, $3)`
+	for _, component := range components {
+		if _, err := conn.Exec(ctx, q, bundleID, component.ItemDetailID, component.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *BundleRepo) Create(ctx context.Context, bundle *model.Bundle) (int, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	var res int
+	q := `INSERT INTO tbl_bundles (bundle_name, currency, price)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	err = tx.QueryRow(ctx, q, bundle.BundleName, bundle.Currency, bundle.ManualPrice).Scan(&res)
+	if isUniqueConstraintError(err) {
+		err = errs.ErrUniqueConstraint
+		return 0, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err = insertComponents(ctx, tx, res, bundle.Components); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *BundleRepo) Get(ctx context.Context, id int) (*model.Bundle, error) {
+	q := `SELECT` + _bundleColumns + `
+		FROM tbl_bundles AS b
+		WHERE b.id = $1
+		AND b.deleted_at IS NULL
+	`
+	bundles, err := r.queryBundles(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(bundles) == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	return bundles[0], nil
+}
+
+func (r *BundleRepo) GetAll(ctx context.Context, filter *model.BundleFilter, page *model.Page) ([]*model.Bundle, *model.PageInfo, error) {
+	where := " WHERE b.deleted_at IS NULL"
+	var queryParams []interface{}
+	if filter.Unavailable != nil {
+		queryParams = append(queryParams, *filter.Unavailable)
+		where += fmt.Sprintf(" AND %s = $%d", _bundleUnavailableExpr, len(queryParams))
+	}
+
+	var pageInfo model.PageInfo
+	q := "SELECT count(*) FROM tbl_bundles AS b" + where
+	err := r.Pool.QueryRow(ctx, q, queryParams...).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	queryParams = append(queryParams, afterID)
+	where += fmt.Sprintf(" AND b.id > $%d", len(queryParams))
+	queryParams = append(queryParams, page.Limit+1)
+
+	q = `SELECT` + _bundleColumns + `
+		FROM tbl_bundles AS b` + where + fmt.Sprintf(" ORDER BY b.id LIMIT $%d", len(queryParams))
+	bundles, err := r.queryBundles(ctx, q, queryParams...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundles, pageInfo.NextCursor = cutPage(bundles, page.Limit, func(bundle *model.Bundle) string {
+		return encodeCursor(strconv.Itoa(bundle.ID))
+	})
+
+	return bundles, &pageInfo, nil
+}
+
+// Update updates bundle and replaces its components.
+func (r *BundleRepo) Update(ctx context.Context, id int, bundle *model.Bundle) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `UPDATE tbl_bundles
+		SET bundle_name = $1,
+		currency = $2,
+		price = $3,
+		updated_at = now()
+		WHERE id = $4
+		AND deleted_at IS NULL
+	`
+	result, err := tx.Exec(ctx, q, bundle.BundleName, bundle.Currency, bundle.ManualPrice, id)
+	if isUniqueConstraintError(err) {
+		err = errs.ErrUniqueConstraint
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
+		return err
+	}
+
+	q = `DELETE FROM tbl_bundle_items WHERE bundle_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+	if err = insertComponents(ctx, tx, id, bundle.Components); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *BundleRepo) Delete(ctx context.Context, id int) error {
+	q := `UPDATE tbl_bundles
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/lmnq/test-thai/database/postgres"
@@ -17,6 +19,55 @@ func NewCategoryRepo(pg *postgres.Postgres) *CategoryRepo {
 	return &CategoryRepo{pg}
 }
 
+const _categoryColumns = `
+			id,
+			category_name,
+			parent_id,
+			tax_profile_id,
+			created_at,
+			updated_at,
+			deleted_at
+`
+
+func scanCategory(row pgx.Row) (*model.Category, error) {
+	var category model.Category
+	err := row.Scan(
+		&category.ID,
+		&category.CategoryName,
+		&category.ParentID,
+		&category.TaxProfileID,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// queryCategories returns categories of the query.
+func (r *CategoryRepo) queryCategories(ctx context.Context, q string, args ...interface{}) ([]*model.Category, error) {
+	rows, err := r.Pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*model.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
+
 func (r *CategoryRepo) Create(ctx context.Context, name string) (int, error) {
 	var res int
 	q := "INSERT INTO tbl_categories (category_name) VALUES ($1) RETURNING id"
@@ -32,24 +83,12 @@ func (r *CategoryRepo) Create(ctx context.Context, name string) (int, error) {
 }
 
 func (r *CategoryRepo) Get(ctx context.Context, id int) (*model.Category, error) {
-	var category model.Category
-	q := `SELECT 
-			id,
-			category_name,
-			created_at,
-			updated_at,
-			deleted_at
+	q := `SELECT` + _categoryColumns + `
 		FROM tbl_categories 
 		WHERE id = $1
 		AND deleted_at IS NULL
 	`
-	err := r.Pool.QueryRow(ctx, q, id).Scan(
-		&category.ID,
-		&category.CategoryName,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-		&category.DeletedAt,
-	)
+	category, err := scanCategory(r.Pool.QueryRow(ctx, q, id))
 	if err == pgx.ErrNoRows {
 		return nil, errs.ErrNotFound
 	}
@@ -57,7 +96,7 @@ func (r *CategoryRepo) Get(ctx context.Context, id int) (*model.Category, error)
 		return nil, err
 	}
 
-	return &category, nil
+	return category, nil
 }
 
 func (r *CategoryRepo) Exists(ctx context.Context, id int) (bool, error) {
@@ -71,43 +110,51 @@ func (r *CategoryRepo) Exists(ctx context.Context, id int) (bool, error) {
 	return result, err
 }
 
-func (r *CategoryRepo) GetAll(ctx context.Context) ([]*model.Category, error) {
-	var categories []*model.Category
-	q := `SELECT 
-			id,
-			category_name,
-			created_at,
-			updated_at,
-			deleted_at
-		FROM tbl_categories
-		WHERE deleted_at IS NULL
-	`
-	rows, err := r.Pool.Query(ctx, q)
-	if err == pgx.ErrNoRows {
-		return nil, errs.ErrNotFound
-	}
+func (r *CategoryRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.Category, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_categories WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var category model.Category
-		err := rows.Scan(
-			&category.ID,
-			&category.CategoryName,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-			&category.DeletedAt,
-		)
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+	}
 
-		categories = append(categories, &category)
+	q = `SELECT` + _categoryColumns + `
+		FROM tbl_categories
+		WHERE deleted_at IS NULL
+		AND id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+	categories, err := r.queryCategories(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return categories, nil
+	categories, pageInfo.NextCursor = cutPage(categories, page.Limit, func(category *model.Category) string {
+		return encodeCursor(strconv.Itoa(category.ID))
+	})
+
+	return categories, &pageInfo, nil
+}
+
+// GetTree returns all categories ordered by name, the tree is built of their parent ids.
+func (r *CategoryRepo) GetTree(ctx context.Context) ([]*model.Category, error) {
+	q := `SELECT` + _categoryColumns + `
+		FROM tbl_categories
+		WHERE deleted_at IS NULL
+		ORDER BY category_name, id
+	`
+
+	return r.queryCategories(ctx, q)
 }
 
 func (r *CategoryRepo) Update(ctx context.Context, id int, name string) error {
@@ -132,13 +179,155 @@ func (r *CategoryRepo) Update(ctx context.Context, id int, name string) error {
 	return nil
 }
 
+// lockCategoryTree serializes the changes of the category tree until the end of the transaction,
+// so concurrent parent changes can't make a cycle.
+func lockCategoryTree(ctx context.Context, conn postgres.Connection) error {
+	_, err := conn.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('tbl_categories.parent_id'))`)
+	return err
+}
+
+// Delete deletes the category, its sub-categories are moved to its parent.
 func (r *CategoryRepo) Delete(ctx context.Context, id int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
 
+	if err = lockCategoryTree(ctx, tx); err != nil {
+		return err
+	}
+
+	var parentID *int
 	q := `UPDATE tbl_categories 
 		SET deleted_at = now()
 		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING parent_id
+	`
+	err = tx.QueryRow(ctx, q, id).Scan(&parentID)
+	if err == pgx.ErrNoRows {
+		err = errs.ErrNotFound
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	q = `UPDATE tbl_categories
+		SET parent_id = $1,
+		updated_at = now()
+		WHERE parent_id = $2
+	`
+	if _, err = tx.Exec(ctx, q, parentID, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetParent moves the category under the parent, nil parent makes it top level.
+// It returns errs.ErrCategoryCycle if the parent is the category or its descendant.
+func (r *CategoryRepo) SetParent(ctx context.Context, id int, parentID *int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	if err = lockCategoryTree(ctx, tx); err != nil {
+		return err
+	}
+
+	if parentID != nil {
+		// the category must not be the parent or one of its ancestors
+		var cycle bool
+		q := `WITH RECURSIVE ancestors AS (
+				SELECT id, parent_id FROM tbl_categories WHERE id = $1
+				UNION
+				SELECT c.id, c.parent_id
+				FROM tbl_categories AS c
+				JOIN ancestors AS a ON c.id = a.parent_id
+			)
+			SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+		`
+		if err = tx.QueryRow(ctx, q, *parentID, id).Scan(&cycle); err != nil {
+			return err
+		}
+		if cycle {
+			err = errs.ErrCategoryCycle
+			return err
+		}
+	}
+
+	q := `UPDATE tbl_categories
+		SET parent_id = $1,
+		updated_at = now()
+		WHERE id = $2
+		AND deleted_at IS NULL
+	`
+	result, err := tx.Exec(ctx, q, parentID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// categorySubtree builds subquery of the ids of the categories matching the condition on alias rc
+// and all of their descendants.
+func categorySubtree(cond string) string {
+	return fmt.Sprintf(`(
+			WITH RECURSIVE subtree AS (
+				SELECT rc.id FROM tbl_categories AS rc WHERE rc.deleted_at IS NULL AND %s
+				UNION
+				SELECT sc.id
+				FROM tbl_categories AS sc
+				JOIN subtree AS st ON sc.parent_id = st.id
+				WHERE sc.deleted_at IS NULL
+			)
+			SELECT id FROM subtree
+		)`, cond)
+}
+
+// SetTaxProfile attaches tax profile to the category, nil profile detaches it.
+func (r *CategoryRepo) SetTaxProfile(ctx context.Context, id int, taxProfileID *int) error {
+	q := `UPDATE tbl_categories
+		SET tax_profile_id = $1,
+		updated_at = now()
+		WHERE id = $2
+		AND deleted_at IS NULL
 	`
-	result, err := r.Pool.Exec(ctx, q, id)
+	result, err := r.Pool.Exec(ctx, q, taxProfileID, id)
 	if err != nil {
 		return err
 	}
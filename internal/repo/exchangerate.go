@@ -0,0 +1,189 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type ExchangeRateRepo struct {
+	*postgres.Postgres
+}
+
+func NewExchangeRateRepo(pg *postgres.Postgres) *ExchangeRateRepo {
+	return &ExchangeRateRepo{pg}
+}
+
+const _exchangeRateColumns = `
+			id,
+			base_currency,
+			quote_currency,
+			rate::float8,
+			created_at,
+			updated_at,
+			deleted_at
+`
+
+func scanExchangeRate(row pgx.Row) (*model.ExchangeRate, error) {
+	var rate model.ExchangeRate
+	err := row.Scan(
+		&rate.ID,
+		&rate.BaseCurrency,
+		&rate.QuoteCurrency,
+		&rate.Rate,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+		&rate.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (r *ExchangeRateRepo) Create(ctx context.Context, rate *model.ExchangeRate) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_exchange_rates (base_currency, quote_currency, rate)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q, rate.BaseCurrency, rate.QuoteCurrency, rate.Rate).Scan(&res)
+	if isUniqueConstraintError(err) {
+		return 0, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *ExchangeRateRepo) Get(ctx context.Context, id int) (*model.ExchangeRate, error) {
+	q := `SELECT` + _exchangeRateColumns + `
+		FROM tbl_exchange_rates
+		WHERE id = $1
+		AND deleted_at IS NULL
+	`
+	rate, err := scanExchangeRate(r.Pool.QueryRow(ctx, q, id))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// GetByCurrencies returns the rate of the currency pair, it does not look for the inverse pair.
+func (r *ExchangeRateRepo) GetByCurrencies(ctx context.Context, base, quote string) (*model.ExchangeRate, error) {
+	q := `SELECT` + _exchangeRateColumns + `
+		FROM tbl_exchange_rates
+		WHERE base_currency = $1
+		AND quote_currency = $2
+		AND deleted_at IS NULL
+	`
+	rate, err := scanExchangeRate(r.Pool.QueryRow(ctx, q, base, quote))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (r *ExchangeRateRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.ExchangeRate, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_exchange_rates WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var rates []*model.ExchangeRate
+	q = `SELECT` + _exchangeRateColumns + `
+		FROM tbl_exchange_rates
+		WHERE deleted_at IS NULL
+		AND id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+	rows, err := r.Pool.Query(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	rates, pageInfo.NextCursor = cutPage(rates, page.Limit, func(rate *model.ExchangeRate) string {
+		return encodeCursor(strconv.Itoa(rate.ID))
+	})
+
+	return rates, &pageInfo, nil
+}
+
+func (r *ExchangeRateRepo) Update(ctx context.Context, id int, rate *model.ExchangeRate) error {
+	q := `UPDATE tbl_exchange_rates
+		SET base_currency = $1,
+		quote_currency = $2,
+		rate = $3,
+		updated_at = now()
+		WHERE id = $4
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, rate.BaseCurrency, rate.QuoteCurrency, rate.Rate, id)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *ExchangeRateRepo) Delete(ctx context.Context, id int) error {
+	q := `UPDATE tbl_exchange_rates
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/lmnq/test-thai/database/postgres"
@@ -36,6 +37,7 @@ func (r *GroupRepo) Get(ctx context.Context, id int) (*model.Group, error) {
 	q := `SELECT 
 			id,
 			group_name,
+			tax_profile_id,
 			created_at,
 			updated_at,
 			deleted_at
@@ -46,6 +48,7 @@ func (r *GroupRepo) Get(ctx context.Context, id int) (*model.Group, error) {
 	err := r.Pool.QueryRow(ctx, q, id).Scan(
 		&group.ID,
 		&group.GroupName,
+		&group.TaxProfileID,
 		&group.CreatedAt,
 		&group.UpdatedAt,
 		&group.DeletedAt,
@@ -71,23 +74,40 @@ func (r *GroupRepo) Exists(ctx context.Context, id int) (bool, error) {
 	return result, err
 }
 
-func (r *GroupRepo) GetAll(ctx context.Context) ([]*model.Group, error) {
+func (r *GroupRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.Group, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_groups WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	var groups []*model.Group
-	q := `SELECT 
+	q = `SELECT 
 			id,
 			group_name,
+			tax_profile_id,
 			created_at,
 			updated_at,
 			deleted_at
 		FROM tbl_groups
 		WHERE deleted_at IS NULL
+		AND id > $1
+		ORDER BY id
+		LIMIT $2
 	`
-	rows, err := r.Pool.Query(ctx, q)
-	if err == pgx.ErrNoRows {
-		return nil, errs.ErrNotFound
-	}
+	rows, err := r.Pool.Query(ctx, q, afterID, page.Limit+1)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
@@ -96,18 +116,26 @@ func (r *GroupRepo) GetAll(ctx context.Context) ([]*model.Group, error) {
 		err := rows.Scan(
 			&group.ID,
 			&group.GroupName,
+			&group.TaxProfileID,
 			&group.CreatedAt,
 			&group.UpdatedAt,
 			&group.DeletedAt,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		groups = append(groups, &group)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	groups, pageInfo.NextCursor = cutPage(groups, page.Limit, func(group *model.Group) string {
+		return encodeCursor(strconv.Itoa(group.ID))
+	})
 
-	return groups, nil
+	return groups, &pageInfo, nil
 }
 
 func (r *GroupRepo) Update(ctx context.Context, id int, name string) error {
@@ -147,3 +175,22 @@ func (r *GroupRepo) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// SetTaxProfile attaches tax profile to the group, nil profile detaches it.
+func (r *GroupRepo) SetTaxProfile(ctx context.Context, id int, taxProfileID *int) error {
+	q := `UPDATE tbl_groups
+		SET tax_profile_id = $1,
+		updated_at = now()
+		WHERE id = $2
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, taxProfileID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type ImageRepo struct {
+	*postgres.Postgres
+}
+
+func NewImageRepo(pg *postgres.Postgres) *ImageRepo {
+	return &ImageRepo{pg}
+}
+
+const _imageColumns = `
+			id,
+			item_id,
+			content_type,
+			size,
+			width,
+			height,
+			storage_key,
+			thumbnail_key,
+			created_at
+`
+
+// image ids of the item in upload order, of the items in tbl_items and in the item detail view
+const (
+	_itemImagesSelect         = `ARRAY(SELECT im.id FROM tbl_item_images AS im WHERE im.item_id = `
+	_itemImagesColumn         = _itemImagesSelect + `tbl_items.id ORDER BY im.id)`
+	_itemDetailImagesColumn   = _itemImagesSelect + `itd.item_id ORDER BY im.id)`
+	_itemImageURLFormat       = "/item/%d/images/%d"
+	_itemImageThumbnailSuffix = "/thumbnail"
+)
+
+// itemImageURLs returns URLs of the image of the item and of its thumbnail, relative to the API root.
+func itemImageURLs(itemID, imageID int) (url, thumbnailURL string) {
+	url = fmt.Sprintf(_itemImageURLFormat, itemID, imageID)
+	return url, url + _itemImageThumbnailSuffix
+}
+
+// imageLinks returns links of the images of the item by id.
+func imageLinks(itemID int, imageIDs []int) []*model.ImageLink {
+	links := make([]*model.ImageLink, 0, len(imageIDs))
+	for _, id := range imageIDs {
+		url, thumbnailURL := itemImageURLs(itemID, id)
+		links = append(links, &model.ImageLink{
+			ID:           id,
+			URL:          url,
+			ThumbnailURL: thumbnailURL,
+		})
+	}
+
+	return links
+}
+
+func scanImage(row pgx.Row) (*model.ItemImage, error) {
+	var image model.ItemImage
+	err := row.Scan(
+		&image.ID,
+		&image.ItemID,
+		&image.ContentType,
+		&image.Size,
+		&image.Width,
+		&image.Height,
+		&image.Key,
+		&image.ThumbnailKey,
+		&image.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	image.URL, image.ThumbnailURL = itemImageURLs(image.ItemID, image.ID)
+
+	return &image, nil
+}
+
+func (r *ImageRepo) Create(ctx context.Context, image *model.ItemImage) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_item_images (item_id, content_type, size, width, height, storage_key, thumbnail_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q,
+		image.ItemID,
+		image.ContentType,
+		image.Size,
+		image.Width,
+		image.Height,
+		image.Key,
+		image.ThumbnailKey,
+	).Scan(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *ImageRepo) Get(ctx context.Context, itemID, id int) (*model.ItemImage, error) {
+	q := `SELECT` + _imageColumns + `
+		FROM tbl_item_images
+		WHERE id = $1 AND item_id = $2
+	`
+	image, err := scanImage(r.Pool.QueryRow(ctx, q, id, itemID))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// GetAll returns the images of the item in upload order.
+func (r *ImageRepo) GetAll(ctx context.Context, itemID int) ([]*model.ItemImage, error) {
+	q := `SELECT` + _imageColumns + `
+		FROM tbl_item_images
+		WHERE item_id = $1
+		ORDER BY id
+	`
+	rows, err := r.Pool.Query(ctx, q, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := []*model.ItemImage{}
+	for rows.Next() {
+		image, err := scanImage(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, image)
+	}
+
+	return images, rows.Err()
+}
+
+// Delete deletes the image and returns it, so that its blobs can be deleted.
+func (r *ImageRepo) Delete(ctx context.Context, itemID, id int) (*model.ItemImage, error) {
+	q := `DELETE FROM tbl_item_images
+		WHERE id = $1 AND item_id = $2
+		RETURNING` + _imageColumns
+	image, err := scanImage(r.Pool.QueryRow(ctx, q, id, itemID))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
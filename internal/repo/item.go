@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/lmnq/test-thai/database/postgres"
@@ -38,16 +40,21 @@ func (r *ItemRepo) Get(ctx context.Context, id int) (*model.Item, error) {
 			item_name,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			` + _itemTagsColumn + `,
+			` + _itemImagesColumn + `
 		FROM tbl_items 
 		WHERE id = $1 AND deleted_at IS NULL
 	`
+	var imageIDs []int
 	err := r.Pool.QueryRow(ctx, q, id).Scan(
 		&item.ID,
 		&item.ItemName,
 		&item.CreatedAt,
 		&item.UpdatedAt,
 		&item.DeletedAt,
+		&item.Tags,
+		&imageIDs,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, errs.ErrNotFound
@@ -55,6 +62,7 @@ func (r *ItemRepo) Get(ctx context.Context, id int) (*model.Item, error) {
 	if err != nil {
 		return nil, err
 	}
+	item.Images = imageLinks(item.ID, imageIDs)
 
 	return &item, nil
 }
@@ -84,48 +92,101 @@ func (r *ItemRepo) Exists(ctx context.Context, id int) (bool, error) {
 	return result, err
 }
 
-func (r *ItemRepo) GetAll(ctx context.Context) ([]*model.Item, error) {
+func (r *ItemRepo) GetAll(ctx context.Context, filter *model.ItemFilter, page *model.Page) ([]*model.Item, *model.PageInfo, error) {
+	where := " WHERE deleted_at IS NULL"
+	var queryParams []interface{}
+	relevance := ""
+	if filter.Q != nil {
+		queryParams = append(queryParams, *filter.Q, containsPattern(*filter.Q))
+		where += " AND " + searchMatch("item_name", "$1", "$2")
+		relevance = searchRank("item_name", "$1", "$2") + "::float8"
+	}
+	if len(filter.Tags) > 0 {
+		queryParams = append(queryParams, filter.Tags)
+		where += " AND " + tagsMatch("tbl_items.id", len(queryParams), filter.TagsMode)
+	}
+
+	var pageInfo model.PageInfo
+	q := "SELECT count(*) FROM tbl_items" + where
+	err := r.Pool.QueryRow(ctx, q, queryParams...).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, or by relevance and id when searching.
+	// cursor holds the key values of the last row of the previous page
+	keys := []keysetKey{{"id", "integer", false}}
+	if relevance != "" {
+		keys = append([]keysetKey{{relevance, "float8", true}}, keys...)
+	}
+	if page.Cursor != "" {
+		values, err := decodeKeysetCursor(page.Cursor, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		var after string
+		after, queryParams = keysetAfter(keys, values, queryParams)
+		where += after
+	}
+	queryParams = append(queryParams, page.Limit+1)
+
 	var items []*model.Item
-	q := `SELECT 
+	q = `SELECT 
 			id,
 			item_name,
 			created_at,
 			updated_at,
-			deleted_at
-		FROM tbl_items
-		WHERE deleted_at IS NULL
-	`
-	rows, err := r.Pool.Query(ctx, q)
+			deleted_at,
+			` + _itemTagsColumn + `,
+			` + _itemImagesColumn
+	if relevance != "" {
+		q += ", " + relevance
+	}
+	q += " FROM tbl_items" + where + keysetOrderBy(keys) + fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	rows, err := r.Pool.Query(ctx, q, queryParams...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		item := model.Item{}
-		err := rows.Scan(
+		var (
+			item     model.Item
+			imageIDs []int
+		)
+		dest := []interface{}{
 			&item.ID,
 			&item.ItemName,
 			&item.CreatedAt,
 			&item.UpdatedAt,
 			&item.DeletedAt,
-		)
-
+			&item.Tags,
+			&imageIDs,
+		}
+		if relevance != "" {
+			dest = append(dest, &item.Relevance)
+		}
+		err := rows.Scan(dest...)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		item.Images = imageLinks(item.ID, imageIDs)
 
 		items = append(items, &item)
 	}
-
 	if err := rows.Err(); err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, errs.ErrNotFound
-		}
-		return nil, err
+		return nil, nil, err
 	}
 
-	return items, nil
+	items, pageInfo.NextCursor = cutPage(items, page.Limit, func(item *model.Item) string {
+		if item.Relevance != nil {
+			return encodeKeysetCursor(keys, strconv.FormatFloat(*item.Relevance, 'f', -1, 64), strconv.Itoa(item.ID))
+		}
+		return encodeKeysetCursor(keys, strconv.Itoa(item.ID))
+	})
+
+	return items, &pageInfo, nil
 }
 
 func (r *ItemRepo) Update(ctx context.Context, id int, name string) error {
@@ -3,12 +3,14 @@ package repo
 import (
 	"context"
 	"fmt"
-	"log"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/lmnq/test-thai/database/postgres"
 	"github.com/lmnq/test-thai/internal/errs"
 	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
 )
 
 type ItemDetailRepo struct {
@@ -35,6 +37,20 @@ func (r *ItemDetailRepo) Create(ctx context.Context, itemDetail *model.ItemDetai
 		}
 	}()
 
+	res, err := createItemDetail(ctx, tx, itemDetail, itemName)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// createItemDetail creates new item (if needed) and new item detail on the transaction conn.
+func createItemDetail(ctx context.Context, conn postgres.Connection, itemDetail *model.ItemDetail, itemName string) (int, error) {
 	// check if item name already exists, create item if not
 	var itemID int
 	q := `INSERT INTO tbl_items (item_name)
@@ -44,9 +60,8 @@ func (r *ItemDetailRepo) Create(ctx context.Context, itemDetail *model.ItemDetai
 		DO UPDATE SET item_name = excluded.item_name
 		RETURNING id
 	`
-	err = tx.QueryRow(ctx, q, itemName).Scan(&itemID)
+	err := conn.QueryRow(ctx, q, itemName).Scan(&itemID)
 	if err != nil {
-		log.Println("AAAA", err)
 		return 0, err
 	}
 	itemDetail.ItemID = itemID
@@ -54,71 +69,194 @@ func (r *ItemDetailRepo) Create(ctx context.Context, itemDetail *model.ItemDetai
 	// create item detail
 	var res int
 	q = `INSERT INTO tbl_item_details
-		(item_id, category_id, group_id, cost, price, sort)
-		VALUES ($1, $2, $3, $4, $5, $6) 
+		(item_id, variant_name, sku, barcode, category_id, group_id, cost, price, currency, sort)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) 
 		RETURNING id
 	`
-	err = tx.QueryRow(ctx, q,
+	err = conn.QueryRow(ctx, q,
 		itemID,
+		itemDetail.VariantName,
+		itemDetail.SKU,
+		itemDetail.Barcode,
 		itemDetail.CategoryID,
 		itemDetail.GroupID,
 		itemDetail.Cost,
 		itemDetail.Price,
+		itemDetail.Currency,
 		itemDetail.Sort,
 	).Scan(&res)
-	// if isUniqueConstraintError(err) {
-	// 	return 0, errs.ErrUniqueConstraint
-	// }
+	// item is upserted above, so only the variant name, the sku or the barcode can be taken
 	if err != nil {
-		log.Println("BBBB", err)
-		return 0, err
+		return 0, itemDetailUniqueError(err)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
+	if err := recordItemDetailPrice(ctx, conn, res); err != nil {
 		return 0, err
 	}
 
 	return res, nil
 }
 
-func (r *ItemDetailRepo) Get(ctx context.Context, id int) (*model.ItemDetailView, error) {
-	var itemDetailView model.ItemDetailView
-	// join tbl_items and tbl_categories and tbl_groups to get itemDetailView
-	q := `SELECT 
+// unique indexes of the item detail codes
+const (
+	_itemDetailSKUIndex     = "idx_tbl_item_details_sku"
+	_itemDetailBarcodeIndex = "idx_tbl_item_details_barcode"
+)
+
+// itemDetailUniqueError converts unique constraint error of the item detail insert or update
+// to the error of the taken value, other errors are returned as is.
+func itemDetailUniqueError(err error) error {
+	switch uniqueConstraintName(err) {
+	case "":
+		return err
+	case _itemDetailSKUIndex:
+		return errs.ErrSKUExists
+	case _itemDetailBarcodeIndex:
+		return errs.ErrBarcodeExists
+	default:
+		return errs.ErrVariantExists
+	}
+}
+
+// margin of the price over the cost, relative to the price and to the cost, numeric to be exact
+const (
+	_itemDetailMarginPercentExpr = `round((itd.price - itd.cost) / NULLIF(itd.price, 0) * 100, 2)`
+	_itemDetailMarkupPercentExpr = `round((itd.price - itd.cost) / NULLIF(itd.cost, 0) * 100, 2)`
+)
+
+// item detail view columns and joins, shared by the item detail view queries
+const (
+	_itemDetailViewColumns = `SELECT 
 			itd.id,
 			itd.item_id,
 			i.item_name,
+			itd.variant_name,
+			itd.sku,
+			itd.barcode,
 			itd.category_id,
 			c.category_name,
 			itd.group_id,
 			g.group_name,
+			` + _itemDetailTagsColumn + `,
+			` + _itemDetailImagesColumn + `,
 			itd.cost,
 			itd.price,
+			itd.price - itd.cost,
+			` + _itemDetailMarginPercentExpr + `,
+			` + _itemDetailMarkupPercentExpr + `,
+			itd.currency,
+			tp.id,
+			tp.profile_name,
+			tp.vat_percent,
+			tp.service_charge_percent,
+			tp.price_includes_tax,
+			tp.created_at,
+			tp.updated_at,
 			itd.sort,
 			itd.created_at,
 			itd.updated_at,
 			itd.deleted_at
+	`
+	// tax profile of the category is used first, then of the group
+	_itemDetailViewFrom = `
 		FROM tbl_item_details AS itd
 		JOIN tbl_items AS i ON itd.item_id = i.id
 		JOIN tbl_categories AS c ON itd.category_id = c.id
 		JOIN tbl_groups AS g ON itd.group_id = g.id
-		WHERE itd.id = $1 AND itd.deleted_at IS NULL
+		LEFT JOIN tbl_tax_profiles AS tp ON tp.id = COALESCE(c.tax_profile_id, g.tax_profile_id) AND tp.deleted_at IS NULL
 	`
-	err := r.Pool.QueryRow(ctx, q, id).Scan(
+)
+
+// scanItemDetailView scans a row selected with _itemDetailViewColumns,
+// extra is the destinations of the columns selected after them.
+func scanItemDetailView(row pgx.Row, itemDetailView *model.ItemDetailView, extra ...interface{}) error {
+	// tax profile columns are null if there is no profile
+	var (
+		taxProfileID                             *int
+		taxProfileName                           *string
+		vatPercent, serviceChargePercent         *decimal.Decimal
+		priceIncludesTax                         *bool
+		taxProfileCreatedAt, taxProfileUpdatedAt *time.Time
+		imageIDs                                 []int
+	)
+	dest := []interface{}{
 		&itemDetailView.ID,
 		&itemDetailView.ItemID,
 		&itemDetailView.ItemName,
+		&itemDetailView.VariantName,
+		&itemDetailView.SKU,
+		&itemDetailView.Barcode,
 		&itemDetailView.CategoryID,
 		&itemDetailView.CategoryName,
 		&itemDetailView.GroupID,
 		&itemDetailView.GroupName,
+		&itemDetailView.Tags,
+		&imageIDs,
 		&itemDetailView.Cost,
 		&itemDetailView.Price,
+		&itemDetailView.Margin,
+		&itemDetailView.MarginPercent,
+		&itemDetailView.MarkupPercent,
+		&itemDetailView.Currency,
+		&taxProfileID,
+		&taxProfileName,
+		&vatPercent,
+		&serviceChargePercent,
+		&priceIncludesTax,
+		&taxProfileCreatedAt,
+		&taxProfileUpdatedAt,
 		&itemDetailView.Sort,
 		&itemDetailView.CreatedAt,
 		&itemDetailView.UpdatedAt,
 		&itemDetailView.DeletedAt,
-	)
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	itemDetailView.Images = imageLinks(itemDetailView.ItemID, imageIDs)
+	itemDetailView.TaxProfile = nil
+	if taxProfileID != nil {
+		itemDetailView.TaxProfile = &model.TaxProfile{
+			ID:                   *taxProfileID,
+			ProfileName:          *taxProfileName,
+			VATPercent:           *vatPercent,
+			ServiceChargePercent: *serviceChargePercent,
+			PriceIncludesTax:     *priceIncludesTax,
+			CreatedAt:            *taxProfileCreatedAt,
+			UpdatedAt:            *taxProfileUpdatedAt,
+		}
+	}
+
+	return nil
+}
+
+func (r *ItemDetailRepo) Get(ctx context.Context, id int) (*model.ItemDetailView, error) {
+	var itemDetailView model.ItemDetailView
+	// join tbl_items and tbl_categories and tbl_groups to get itemDetailView
+	q := _itemDetailViewColumns + _itemDetailViewFrom + `
+		WHERE itd.id = $1 AND itd.deleted_at IS NULL
+	`
+	err := scanItemDetailView(r.Pool.QueryRow(ctx, q, id), &itemDetailView)
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &itemDetailView, nil
+}
+
+// GetByBarcode returns the item detail by EAN-13 or UPC-A barcode,
+// UPC-A matches its EAN-13 form with leading zero and the other way round.
+func (r *ItemDetailRepo) GetByBarcode(ctx context.Context, barcode string) (*model.ItemDetailView, error) {
+	var itemDetailView model.ItemDetailView
+	q := _itemDetailViewColumns + _itemDetailViewFrom + `
+		WHERE itd.barcode IS NOT NULL AND lpad(itd.barcode, 13, '0') = lpad($1, 13, '0') AND itd.deleted_at IS NULL
+	`
+	err := scanItemDetailView(r.Pool.QueryRow(ctx, q, barcode), &itemDetailView)
 	if err == pgx.ErrNoRows {
 		return nil, errs.ErrNotFound
 	}
@@ -140,81 +278,384 @@ func (r *ItemDetailRepo) Exists(ctx context.Context, id int) (bool, error) {
 	return result, err
 }
 
-func (r *ItemDetailRepo) GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter) ([]*model.ItemDetailView, error) {
-	var itemDetailViews []*model.ItemDetailView
-	// join tbl_items and tbl_categories and tbl_groups to get itemDetailView
-	q := `SELECT 
-			itd.id,
-			itd.item_id,
-			i.item_name,
-			itd.category_id,
-			c.category_name,
-			itd.group_id,
-			g.group_name,
-			itd.cost,
-			itd.price,
-			itd.sort,
-			itd.created_at,
-			itd.updated_at,
-			itd.deleted_at
-		FROM tbl_item_details AS itd
-		JOIN tbl_items AS i ON itd.item_id = i.id
-		JOIN tbl_categories AS c ON itd.category_id = c.id
-		JOIN tbl_groups AS g ON itd.group_id = g.id
-		WHERE 1=1 AND itd.deleted_at IS NULL
-	`
-	var queryParams []interface{}
+// itemDetailFilterWhere builds WHERE clause of the item detail list by filter.
+// relevance is the search rank expression, empty if the filter has no q.
+func itemDetailFilterWhere(filter *model.ItemDetailFilter) (where string, queryParams []interface{}, relevance string) {
+	where = " WHERE 1=1 AND itd.deleted_at IS NULL"
 	if filter.ID != nil {
 		queryParams = append(queryParams, filter.ID)
-		q += fmt.Sprintf(" AND itd.id = $%d", len(queryParams))
+		where += fmt.Sprintf(" AND itd.id = $%d", len(queryParams))
+	}
+	// names match in the default locale or in any translation
+	if filter.Q != nil {
+		queryParams = append(queryParams, *filter.Q, containsPattern(*filter.Q))
+		q, pattern := fmt.Sprintf("$%d", len(queryParams)-1), fmt.Sprintf("$%d", len(queryParams))
+		match := func(column string) string { return searchMatch(column, q, pattern) }
+		rank := func(column string) string { return searchRank(column, q, pattern) }
+		where += fmt.Sprintf(" AND (%s OR %s OR %s OR %s OR %s OR %s)",
+			match("i.item_name"), translationMatch(model.TranslationItem, "i.id", match),
+			match("c.category_name"), translationMatch(model.TranslationCategory, "c.id", match),
+			match("g.group_name"), translationMatch(model.TranslationGroup, "g.id", match),
+		)
+		// GREATEST ignores the null ranks of the entities without translations
+		relevance = fmt.Sprintf("GREATEST(%s, %s, %s, %s, %s, %s)::float8",
+			rank("i.item_name"), translationRank(model.TranslationItem, "i.id", rank),
+			rank("c.category_name"), translationRank(model.TranslationCategory, "c.id", rank),
+			rank("g.group_name"), translationRank(model.TranslationGroup, "g.id", rank),
+		)
 	}
 	if filter.ItemName != nil {
 		queryParams = append(queryParams, filter.ItemName)
-		q += fmt.Sprintf(" AND i.item_name = $%d", len(queryParams))
+		where += " AND " + nameEquals("i.item_name", model.TranslationItem, "i.id", len(queryParams))
+	}
+	// the category filters match the sub-categories too if they are included
+	if filter.CategoryID != nil {
+		queryParams = append(queryParams, *filter.CategoryID)
+		if filter.IncludeSubcategories {
+			where += " AND itd.category_id IN " + categorySubtree(fmt.Sprintf("rc.id = $%d", len(queryParams)))
+		} else {
+			where += fmt.Sprintf(" AND itd.category_id = $%d", len(queryParams))
+		}
 	}
 	if filter.CategoryName != nil {
 		queryParams = append(queryParams, filter.CategoryName)
-		q += fmt.Sprintf(" AND c.category_name = $%d", len(queryParams))
+		if filter.IncludeSubcategories {
+			where += " AND itd.category_id IN " + categorySubtree(nameEquals("rc.category_name", model.TranslationCategory, "rc.id", len(queryParams)))
+		} else {
+			where += " AND " + nameEquals("c.category_name", model.TranslationCategory, "c.id", len(queryParams))
+		}
 	}
 	if filter.GroupName != nil {
 		queryParams = append(queryParams, filter.GroupName)
-		q += fmt.Sprintf(" AND g.group_name = $%d", len(queryParams))
+		where += " AND " + nameEquals("g.group_name", model.TranslationGroup, "g.id", len(queryParams))
+	}
+	if len(filter.Tags) > 0 {
+		queryParams = append(queryParams, filter.Tags)
+		where += " AND " + tagsMatch("itd.item_id", len(queryParams), filter.TagsMode)
+	}
+	if filter.MinMarginPercent != nil {
+		queryParams = append(queryParams, *filter.MinMarginPercent)
+		where += fmt.Sprintf(" AND %s >= $%d::numeric", _itemDetailMarginPercentExpr, len(queryParams))
+	}
+	if filter.MaxMarginPercent != nil {
+		queryParams = append(queryParams, *filter.MaxMarginPercent)
+		where += fmt.Sprintf(" AND %s <= $%d::numeric", _itemDetailMarginPercentExpr, len(queryParams))
 	}
 
-	rows, err := r.Pool.Query(ctx, q, queryParams...)
-	if err == pgx.ErrNoRows {
-		return nil, errs.ErrNotFound
+	return where, queryParams, relevance
+}
+
+// nameEquals builds condition matching the name column or any translation of the entity with id
+// to the query param n.
+func nameEquals(column, entity, id string, n int) string {
+	eq := func(column string) string { return fmt.Sprintf("%s = $%d", column, n) }
+	return fmt.Sprintf("(%s OR %s)", eq(column), translationMatch(entity, id, eq))
+}
+
+// itemDetailOrderColumn is an item detail view column the list can be ordered by.
+// value returns the column value of the row to be put into the cursor.
+type itemDetailOrderColumn struct {
+	expr    string
+	sqlType string
+	value   func(v *model.ItemDetailView) string
+}
+
+const _cursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+var (
+	_itemDetailOrderColumns = map[string]itemDetailOrderColumn{
+		model.ItemDetailOrderSort: {"itd.sort", "integer", func(v *model.ItemDetailView) string {
+			return strconv.Itoa(v.Sort)
+		}},
+		model.ItemDetailOrderPrice: {"itd.price", "numeric", func(v *model.ItemDetailView) string {
+			return v.Price.String()
+		}},
+		model.ItemDetailOrderCost: {"itd.cost", "numeric", func(v *model.ItemDetailView) string {
+			return v.Cost.String()
+		}},
+		model.ItemDetailOrderItemName: {"i.item_name", "text", func(v *model.ItemDetailView) string {
+			return v.ItemName
+		}},
+		model.ItemDetailOrderCategoryName: {"c.category_name", "text", func(v *model.ItemDetailView) string {
+			return v.CategoryName
+		}},
+		model.ItemDetailOrderGroupName: {"g.group_name", "text", func(v *model.ItemDetailView) string {
+			return v.GroupName
+		}},
+		model.ItemDetailOrderCreatedAt: {"itd.created_at", "timestamp", func(v *model.ItemDetailView) string {
+			return v.CreatedAt.Format(_cursorTimeLayout)
+		}},
+		model.ItemDetailOrderUpdatedAt: {"itd.updated_at", "timestamp", func(v *model.ItemDetailView) string {
+			return v.UpdatedAt.Format(_cursorTimeLayout)
+		}},
+	}
+
+	// id is always the last order key, so the order is unique for the keyset pagination
+	_itemDetailIDOrderColumn = itemDetailOrderColumn{"itd.id", "integer", func(v *model.ItemDetailView) string {
+		return strconv.Itoa(v.ID)
+	}}
+)
+
+// itemDetailOrder builds order keys of the item detail list by filter
+// and the columns to get the key values of a row.
+func itemDetailOrder(filter *model.ItemDetailFilter, relevance string) ([]itemDetailOrderColumn, []keysetKey, error) {
+	columns := make([]itemDetailOrderColumn, 0, len(filter.OrderBy)+2)
+	keys := make([]keysetKey, 0, len(filter.OrderBy)+2)
+	// search results are ranked by relevance first
+	if relevance != "" {
+		columns = append(columns, itemDetailOrderColumn{relevance, "float8", func(v *model.ItemDetailView) string {
+			return strconv.FormatFloat(*v.Relevance, 'f', -1, 64)
+		}})
+		keys = append(keys, keysetKey{relevance, "float8", true})
+	}
+	for _, order := range filter.OrderBy {
+		column, ok := _itemDetailOrderColumns[order.Field]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown item detail order field %q", order.Field)
+		}
+		columns = append(columns, column)
+		keys = append(keys, keysetKey{column.expr, column.sqlType, order.Desc})
 	}
+	columns = append(columns, _itemDetailIDOrderColumn)
+	keys = append(keys, keysetKey{_itemDetailIDOrderColumn.expr, _itemDetailIDOrderColumn.sqlType, false})
+
+	return columns, keys, nil
+}
+
+func (r *ItemDetailRepo) GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemDetailView, *model.PageInfo, error) {
+	where, queryParams, relevance := itemDetailFilterWhere(filter)
+
+	var pageInfo model.PageInfo
+	q := "SELECT count(*)" + _itemDetailViewFrom + where
+	err := r.Pool.QueryRow(ctx, q, queryParams...).Scan(&pageInfo.Total)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	columns, keys, err := itemDetailOrder(filter, relevance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination, cursor holds the order key values of the last row of the previous page
+	if page.Cursor != "" {
+		values, err := decodeKeysetCursor(page.Cursor, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		var after string
+		after, queryParams = keysetAfter(keys, values, queryParams)
+		where += after
+	}
+	queryParams = append(queryParams, page.Limit+1)
+
+	var itemDetailViews []*model.ItemDetailView
+	// join tbl_items and tbl_categories and tbl_groups to get itemDetailView
+	q = _itemDetailViewColumns
+	if relevance != "" {
+		q += ", " + relevance
+	}
+	q += _itemDetailViewFrom + where +
+		keysetOrderBy(keys) + fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	rows, err := r.Pool.Query(ctx, q, queryParams...)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
 		var itemDetailView model.ItemDetailView
-		err := rows.Scan(
-			&itemDetailView.ID,
-			&itemDetailView.ItemID,
-			&itemDetailView.ItemName,
-			&itemDetailView.CategoryID,
-			&itemDetailView.CategoryName,
-			&itemDetailView.GroupID,
-			&itemDetailView.GroupName,
-			&itemDetailView.Cost,
-			&itemDetailView.Price,
-			&itemDetailView.Sort,
-			&itemDetailView.CreatedAt,
-			&itemDetailView.UpdatedAt,
-			&itemDetailView.DeletedAt,
-		)
+		var extra []interface{}
+		if relevance != "" {
+			extra = append(extra, &itemDetailView.Relevance)
+		}
+		err := scanItemDetailView(rows, &itemDetailView, extra...)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		itemDetailViews = append(itemDetailViews, &itemDetailView)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
 
-	return itemDetailViews, nil
+	itemDetailViews, pageInfo.NextCursor = cutPage(itemDetailViews, page.Limit, func(v *model.ItemDetailView) string {
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			values = append(values, column.value(v))
+		}
+		return encodeKeysetCursor(keys, values...)
+	})
+
+	return itemDetailViews, &pageInfo, nil
+}
+
+// item order of the grouped item detail list, id makes it unique for the keyset pagination
+var _itemVariantsOrderKeys = []keysetKey{{"i.item_name", "text", false}, {"i.id", "integer", false}}
+
+// GetAllGrouped returns the item detail list by filter grouped by item, the page is a page of items.
+// Items are ordered by name, item details of the item by the filter order.
+func (r *ItemDetailRepo) GetAllGrouped(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemVariants, *model.PageInfo, error) {
+	where, queryParams, relevance := itemDetailFilterWhere(filter)
+
+	var pageInfo model.PageInfo
+	q := "SELECT count(DISTINCT itd.item_id)" + _itemDetailViewFrom + where
+	err := r.Pool.QueryRow(ctx, q, queryParams...).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, keys, err := itemDetailOrder(filter, relevance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// page of items having item details by filter
+	itemWhere, itemParams := where, queryParams
+	if page.Cursor != "" {
+		values, err := decodeKeysetCursor(page.Cursor, _itemVariantsOrderKeys)
+		if err != nil {
+			return nil, nil, err
+		}
+		var after string
+		after, itemParams = keysetAfter(_itemVariantsOrderKeys, values, itemParams)
+		itemWhere += after
+	}
+	itemParams = append(itemParams, page.Limit+1)
+
+	q = "SELECT i.id, i.item_name" + _itemDetailViewFrom + itemWhere +
+		" GROUP BY i.id, i.item_name" + keysetOrderBy(_itemVariantsOrderKeys) + fmt.Sprintf(" LIMIT $%d", len(itemParams))
+
+	rows, err := r.Pool.Query(ctx, q, itemParams...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []*model.ItemVariants
+	for rows.Next() {
+		item := model.ItemVariants{Variants: []*model.ItemDetailView{}}
+		if err := rows.Scan(&item.ItemID, &item.ItemName); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		items = append(items, &item)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	items, pageInfo.NextCursor = cutPage(items, page.Limit, func(item *model.ItemVariants) string {
+		return encodeKeysetCursor(_itemVariantsOrderKeys, item.ItemName, strconv.Itoa(item.ItemID))
+	})
+	if len(items) == 0 {
+		return items, &pageInfo, nil
+	}
+
+	// item details by filter of the items of the page
+	itemIDs := make([]int, 0, len(items))
+	itemsByID := make(map[int]*model.ItemVariants, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ItemID)
+		itemsByID[item.ItemID] = item
+	}
+	queryParams = append(queryParams, itemIDs)
+
+	q = _itemDetailViewColumns
+	if relevance != "" {
+		q += ", " + relevance
+	}
+	q += _itemDetailViewFrom + where + fmt.Sprintf(" AND itd.item_id = ANY($%d)", len(queryParams)) + keysetOrderBy(keys)
+
+	rows, err = r.Pool.Query(ctx, q, queryParams...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemDetailView model.ItemDetailView
+		var extra []interface{}
+		if relevance != "" {
+			extra = append(extra, &itemDetailView.Relevance)
+		}
+		err := scanItemDetailView(rows, &itemDetailView, extra...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		item := itemsByID[itemDetailView.ItemID]
+		item.Variants = append(item.Variants, &itemDetailView)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return items, &pageInfo, nil
+}
+
+// Export passes the item detail list by filter to fn row by row as they are read from the cursor,
+// so the whole list is never held in memory. Rows are in the list order.
+// Names are translated to the locale in the same query, so the export holds only one connection,
+// empty locale keeps the names.
+func (r *ItemDetailRepo) Export(ctx context.Context, filter *model.ItemDetailFilter, locale string, fn func(*model.ItemDetailView) error) error {
+	where, queryParams, relevance := itemDetailFilterWhere(filter)
+
+	_, keys, err := itemDetailOrder(filter, relevance)
+	if err != nil {
+		return err
+	}
+
+	q := _itemDetailViewColumns
+	if relevance != "" {
+		q += ", " + relevance
+	}
+	if locale != "" {
+		queryParams = append(queryParams, locale)
+		param := fmt.Sprintf("$%d", len(queryParams))
+		q += ", " + translationName(model.TranslationItem, "i.id", param) +
+			", " + translationName(model.TranslationCategory, "c.id", param) +
+			", " + translationName(model.TranslationGroup, "g.id", param)
+	}
+	q += _itemDetailViewFrom + where + keysetOrderBy(keys)
+
+	rows, err := r.Pool.Query(ctx, q, queryParams...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemDetailView model.ItemDetailView
+		var itemName, categoryName, groupName *string
+		var extra []interface{}
+		if relevance != "" {
+			extra = append(extra, &itemDetailView.Relevance)
+		}
+		if locale != "" {
+			extra = append(extra, &itemName, &categoryName, &groupName)
+		}
+		err := scanItemDetailView(rows, &itemDetailView, extra...)
+		if err != nil {
+			return err
+		}
+		if itemName != nil {
+			itemDetailView.ItemName = *itemName
+		}
+		if categoryName != nil {
+			itemDetailView.CategoryName = *categoryName
+		}
+		if groupName != nil {
+			itemDetailView.GroupName = *groupName
+		}
+
+		if err := fn(&itemDetailView); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
 }
 
 func (r *ItemDetailRepo) Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) error {
@@ -233,43 +674,178 @@ func (r *ItemDetailRepo) Update(ctx context.Context, id int, itemName string, it
 		}
 	}()
 
+	err = updateItemDetail(ctx, tx, id, itemName, itemDetail)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateItemDetail updates item name and item detail on the transaction conn.
+func updateItemDetail(ctx context.Context, conn postgres.Connection, id int, itemName string, itemDetail *model.ItemDetail) error {
 	// get item_id by current item_detail.id, then update items.item_name
 	var itemID int
 	q := `SELECT item_id FROM tbl_item_details WHERE id = $1 AND deleted_at IS NULL`
-	err = tx.QueryRow(ctx, q, id).Scan(&itemID)
+	err := conn.QueryRow(ctx, q, id).Scan(&itemID)
+	if err == pgx.ErrNoRows {
+		return errs.ErrNotFound
+	}
 	if err != nil {
 		return err
 	}
 
 	q = `UPDATE tbl_items SET item_name = $1 WHERE id = $2`
-	_, err = tx.Exec(ctx, q, itemName, itemID)
+	_, err = conn.Exec(ctx, q, itemName, itemID)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
 	if err != nil {
 		return err
 	}
 
 	q = `UPDATE tbl_item_details
 		SET 
-			category_id = $1,
-			group_id = $2,
-			cost = $3,
-			price = $4,
-			sort = $5,
+			variant_name = $1,
+			sku = $2,
+			barcode = $3,
+			category_id = $4,
+			group_id = $5,
+			cost = $6,
+			price = $7,
+			currency = $8,
+			sort = $9,
 			updated_at = now()
-		WHERE id = $6
+		WHERE id = $10
 		AND deleted_at IS NULL
 	`
-	_, err = tx.Exec(ctx, q,
+	_, err = conn.Exec(ctx, q,
+		itemDetail.VariantName,
+		itemDetail.SKU,
+		itemDetail.Barcode,
 		itemDetail.CategoryID,
 		itemDetail.GroupID,
 		itemDetail.Cost,
 		itemDetail.Price,
+		itemDetail.Currency,
 		itemDetail.Sort,
 		id,
 	)
 	if err != nil {
+		return itemDetailUniqueError(err)
+	}
+
+	return recordItemDetailPrice(ctx, conn, id)
+}
+
+func (r *ItemDetailRepo) Patch(ctx context.Context, id int, patch *model.ItemDetailPatch) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	// get item_id by current item_detail.id, then update items.item_name
+	if patch.ItemName != nil {
+		var itemID int
+		q := `SELECT item_id FROM tbl_item_details WHERE id = $1 AND deleted_at IS NULL`
+		err = tx.QueryRow(ctx, q, id).Scan(&itemID)
+		if err == pgx.ErrNoRows {
+			err = errs.ErrNotFound
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		q = `UPDATE tbl_items SET item_name = $1, updated_at = now() WHERE id = $2`
+		_, err = tx.Exec(ctx, q, *patch.ItemName, itemID)
+		if isUniqueConstraintError(err) {
+			err = errs.ErrUniqueConstraint
+			return err
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// update only the columns that are set in the patch
+	set := ""
+	var queryParams []interface{}
+	setColumn := func(column string, value interface{}) {
+		queryParams = append(queryParams, value)
+		set += fmt.Sprintf("%s = $%d, ", column, len(queryParams))
+	}
+	if patch.VariantName != nil {
+		// empty variant name makes the item detail not a variant
+		var variantName *string
+		if *patch.VariantName != "" {
+			variantName = patch.VariantName
+		}
+		setColumn("variant_name", variantName)
+	}
+	// empty sku and barcode remove them
+	if patch.SKU != nil {
+		setColumn("sku", nullIfEmpty(*patch.SKU))
+	}
+	if patch.Barcode != nil {
+		setColumn("barcode", nullIfEmpty(*patch.Barcode))
+	}
+	if patch.CategoryID != nil {
+		setColumn("category_id", *patch.CategoryID)
+	}
+	if patch.GroupID != nil {
+		setColumn("group_id", *patch.GroupID)
+	}
+	if patch.Cost != nil {
+		setColumn("cost", *patch.Cost)
+	}
+	if patch.Price != nil {
+		setColumn("price", *patch.Price)
+	}
+	if patch.Currency != nil {
+		setColumn("currency", *patch.Currency)
+	}
+	if patch.Sort != nil {
+		setColumn("sort", *patch.Sort)
+	}
+	queryParams = append(queryParams, id)
+
+	q := fmt.Sprintf(`UPDATE tbl_item_details
+		SET %supdated_at = now()
+		WHERE id = $%d
+		AND deleted_at IS NULL
+	`, set, len(queryParams))
+	result, err := tx.Exec(ctx, q, queryParams...)
+	if err != nil {
+		err = itemDetailUniqueError(err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
 		return err
 	}
 
+	if patch.Cost != nil || patch.Price != nil || patch.Currency != nil {
+		err = recordItemDetailPrice(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return err
 	}
@@ -278,11 +854,16 @@ func (r *ItemDetailRepo) Update(ctx context.Context, id int, itemName string, it
 }
 
 func (r *ItemDetailRepo) Delete(ctx context.Context, id int) error {
+	return deleteItemDetail(ctx, r.Pool, id)
+}
+
+// deleteItemDetail soft deletes item detail on the conn.
+func deleteItemDetail(ctx context.Context, conn postgres.Connection, id int) error {
 	q := `UPDATE tbl_item_details 
 		SET deleted_at = now()
 		WHERE id = $1 AND deleted_at IS NULL
 	`
-	result, err := r.Pool.Exec(ctx, q, id)
+	result, err := conn.Exec(ctx, q, id)
 	if err != nil {
 		return err
 	}
@@ -292,3 +873,72 @@ func (r *ItemDetailRepo) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// Bulk applies the item detail operations in one transaction.
+// If atomic, the first failed operation rolls back all of them,
+// otherwise each operation runs in its own savepoint and a failed one does not affect the others.
+// ids holds ids of the created item details, opErrs holds errors of the failed operations.
+func (r *ItemDetailRepo) Bulk(ctx context.Context, ops []*model.ItemDetailBulkOp, atomic bool) (ids []int, opErrs []error, err error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	ids = make([]int, len(ops))
+	opErrs = make([]error, len(ops))
+	for i, op := range ops {
+		if atomic {
+			ids[i], opErrs[i] = applyItemDetailBulkOp(ctx, tx, op)
+			if opErrs[i] != nil {
+				// the transaction is aborted, nothing else can be applied
+				tx.Rollback(ctx)
+				return ids, opErrs, nil
+			}
+			continue
+		}
+
+		var savepoint pgx.Tx
+		savepoint, err = tx.Begin(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids[i], opErrs[i] = applyItemDetailBulkOp(ctx, savepoint, op)
+		if opErrs[i] != nil {
+			err = savepoint.Rollback(ctx)
+		} else {
+			err = savepoint.Commit(ctx)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return ids, opErrs, nil
+}
+
+func applyItemDetailBulkOp(ctx context.Context, conn postgres.Connection, op *model.ItemDetailBulkOp) (int, error) {
+	switch op.Op {
+	case model.BulkOpCreate:
+		return createItemDetail(ctx, conn, op.ItemDetail, op.ItemName)
+	case model.BulkOpUpdate:
+		return op.ID, updateItemDetail(ctx, conn, op.ID, op.ItemName, op.ItemDetail)
+	case model.BulkOpDelete:
+		return op.ID, deleteItemDetail(ctx, conn, op.ID)
+	default:
+		return 0, fmt.Errorf("unknown bulk operation %q", op.Op)
+	}
+}
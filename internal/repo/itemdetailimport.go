@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+// Import creates or updates item details of the rows in one transaction.
+// Categories and groups are resolved by name and created if missing,
+// item detail of the row is matched by item name, category and group, variants are not matched.
+// Each row runs in its own savepoint, rowErrs holds errors of the failed rows.
+// The transaction is committed only if commit is true and no row failed, otherwise it is rolled back,
+// so the results show what the import does without writing anything.
+func (r *ItemDetailRepo) Import(ctx context.Context, rows []*model.ItemDetailImportRow, commit bool) (results []*model.ItemDetailImportResult, rowErrs []error, committed bool, err error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer func() {
+		if err != nil || !committed {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	results = make([]*model.ItemDetailImportResult, len(rows))
+	rowErrs = make([]error, len(rows))
+	failed := false
+	for i, row := range rows {
+		var savepoint pgx.Tx
+		savepoint, err = tx.Begin(ctx)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		results[i], rowErrs[i] = importItemDetailRow(ctx, savepoint, row)
+		if rowErrs[i] != nil {
+			failed = true
+			err = savepoint.Rollback(ctx)
+		} else {
+			err = savepoint.Commit(ctx)
+		}
+		if err != nil {
+			return nil, nil, false, err
+		}
+	}
+
+	if !commit || failed {
+		return results, rowErrs, false, nil
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, false, err
+	}
+
+	return results, rowErrs, true, nil
+}
+
+func importItemDetailRow(ctx context.Context, conn postgres.Connection, row *model.ItemDetailImportRow) (*model.ItemDetailImportResult, error) {
+	result := &model.ItemDetailImportResult{
+		Line:         row.Line,
+		Action:       model.ImportActionError,
+		ItemName:     row.ItemName,
+		CategoryName: row.CategoryName,
+		GroupName:    row.GroupName,
+	}
+
+	var (
+		categoryID, groupID int
+		err                 error
+	)
+	categoryID, result.NewCategory, err = getOrCreateIDByName(ctx, conn, "tbl_categories", "category_name", row.CategoryName)
+	if err != nil {
+		return result, err
+	}
+	groupID, result.NewGroup, err = getOrCreateIDByName(ctx, conn, "tbl_groups", "group_name", row.GroupName)
+	if err != nil {
+		return result, err
+	}
+
+	after := &model.ItemDetailImportValues{
+		Cost:     row.Cost,
+		Price:    row.Price,
+		Currency: row.Currency,
+		Sort:     row.Sort,
+	}
+
+	var before model.ItemDetailImportValues
+	q := `SELECT itd.id, itd.cost, itd.price, itd.currency, itd.sort
+		FROM tbl_item_details AS itd
+		JOIN tbl_items AS i ON itd.item_id = i.id
+		WHERE i.item_name = $1
+		AND itd.category_id = $2
+		AND itd.group_id = $3
+		AND itd.variant_name IS NULL
+		AND itd.deleted_at IS NULL
+		AND i.deleted_at IS NULL
+		ORDER BY itd.id
+		LIMIT 1
+	`
+	err = conn.QueryRow(ctx, q, row.ItemName, categoryID, groupID).Scan(
+		&result.ItemDetailID,
+		&before.Cost,
+		&before.Price,
+		&before.Currency,
+		&before.Sort,
+	)
+	if err == pgx.ErrNoRows {
+		// reuse item upsert of item detail create
+		result.ItemDetailID, err = createItemDetail(ctx, conn, &model.ItemDetail{
+			CategoryID: categoryID,
+			GroupID:    groupID,
+			Cost:       row.Cost,
+			Price:      row.Price,
+			Currency:   row.Currency,
+			Sort:       row.Sort,
+		}, row.ItemName)
+		if err != nil {
+			return result, err
+		}
+		result.Action = model.ImportActionCreate
+		result.After = after
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if before == *after {
+		result.Action = model.ImportActionUnchanged
+		return result, nil
+	}
+
+	q = `UPDATE tbl_item_details
+		SET 
+			cost = $1,
+			price = $2,
+			currency = $3,
+			sort = $4,
+			updated_at = now()
+		WHERE id = $5
+	`
+	_, err = conn.Exec(ctx, q, row.Cost, row.Price, row.Currency, row.Sort, result.ItemDetailID)
+	if err != nil {
+		return result, err
+	}
+	if err := recordItemDetailPrice(ctx, conn, result.ItemDetailID); err != nil {
+		return result, err
+	}
+	result.Action = model.ImportActionUpdate
+	result.Before = &before
+	result.After = after
+
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+// recordItemDetailPrice adds current cost, price and currency of the item detail to the price history
+// if they differ from the last recorded ones. It must run on the transaction of the change,
+// so the history has the same timestamp and is rolled back together with it.
+func recordItemDetailPrice(ctx context.Context, conn postgres.Connection, id int) error {
+	q := `INSERT INTO tbl_item_detail_prices (item_detail_id, cost, price, currency)
+		SELECT itd.id, itd.cost, itd.price, itd.currency
+		FROM tbl_item_details AS itd
+		LEFT JOIN LATERAL (
+			SELECT p.cost, p.price, p.currency
+			FROM tbl_item_detail_prices AS p
+			WHERE p.item_detail_id = itd.id
+			ORDER BY p.changed_at DESC, p.id DESC
+			LIMIT 1
+		) AS last ON true
+		WHERE itd.id = $1
+		AND (last.cost IS DISTINCT FROM itd.cost
+			OR last.price IS DISTINCT FROM itd.price
+			OR last.currency IS DISTINCT FROM itd.currency)
+	`
+	_, err := conn.Exec(ctx, q, id)
+
+	return err
+}
+
+func (r *ItemDetailRepo) PriceHistory(ctx context.Context, id int, filter *model.PriceHistoryFilter) ([]*model.ItemDetailPrice, error) {
+	q := `SELECT item_detail_id, cost, price, currency, changed_at
+		FROM tbl_item_detail_prices
+		WHERE item_detail_id = $1
+	`
+	queryParams := []interface{}{id}
+	if filter.From != nil {
+		queryParams = append(queryParams, *filter.From)
+		q += fmt.Sprintf(" AND changed_at >= $%d::timestamptz", len(queryParams))
+	}
+	if filter.To != nil {
+		queryParams = append(queryParams, *filter.To)
+		q += fmt.Sprintf(" AND changed_at < $%d::timestamptz", len(queryParams))
+	}
+	q += " ORDER BY changed_at, id"
+
+	rows, err := r.Pool.Query(ctx, q, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := []*model.ItemDetailPrice{}
+	for rows.Next() {
+		var price model.ItemDetailPrice
+		err := rows.Scan(&price.ItemDetailID, &price.Cost, &price.Price, &price.Currency, &price.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, &price)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+func (r *ItemDetailRepo) PriceAt(ctx context.Context, id int, at time.Time) (*model.ItemDetailPrice, error) {
+	var price model.ItemDetailPrice
+	q := `SELECT item_detail_id, cost, price, currency, changed_at
+		FROM tbl_item_detail_prices
+		WHERE item_detail_id = $1
+		AND changed_at <= $2::timestamptz
+		ORDER BY changed_at DESC, id DESC
+		LIMIT 1
+	`
+	err := r.Pool.QueryRow(ctx, q, id, at).Scan(&price.ItemDetailID, &price.Cost, &price.Price, &price.Currency, &price.ChangedAt)
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+// pending scheduled prices are neither applied nor canceled
+const _scheduledPricePending = `applied_at IS NULL AND canceled_at IS NULL`
+
+func (r *ItemDetailRepo) SchedulePrice(ctx context.Context, scheduledPrice *model.ItemDetailScheduledPrice) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_item_detail_scheduled_prices (item_detail_id, cost, price, effective_from)
+		VALUES ($1, $2, $3, $4::timestamptz)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q,
+		scheduledPrice.ItemDetailID,
+		scheduledPrice.Cost,
+		scheduledPrice.Price,
+		scheduledPrice.EffectiveFrom,
+	).Scan(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// ScheduledPrices returns pending price changes of the item detail in the order they are applied.
+func (r *ItemDetailRepo) ScheduledPrices(ctx context.Context, id int) ([]*model.ItemDetailScheduledPrice, error) {
+	q := `SELECT id, item_detail_id, cost, price, effective_from, created_at
+		FROM tbl_item_detail_scheduled_prices
+		WHERE item_detail_id = $1
+		AND ` + _scheduledPricePending + `
+		ORDER BY effective_from, id
+	`
+	rows, err := r.Pool.Query(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scheduledPrices := []*model.ItemDetailScheduledPrice{}
+	for rows.Next() {
+		var scheduledPrice model.ItemDetailScheduledPrice
+		err := rows.Scan(
+			&scheduledPrice.ID,
+			&scheduledPrice.ItemDetailID,
+			&scheduledPrice.Cost,
+			&scheduledPrice.Price,
+			&scheduledPrice.EffectiveFrom,
+			&scheduledPrice.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledPrices = append(scheduledPrices, &scheduledPrice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return scheduledPrices, nil
+}
+
+// CancelScheduledPrice cancels pending price change of the item detail.
+func (r *ItemDetailRepo) CancelScheduledPrice(ctx context.Context, id, scheduledPriceID int) error {
+	q := `UPDATE tbl_item_detail_scheduled_prices
+		SET canceled_at = now()
+		WHERE id = $1
+		AND item_detail_id = $2
+		AND ` + _scheduledPricePending
+	result, err := r.Pool.Exec(ctx, q, scheduledPriceID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// ApplyScheduledPrices applies pending price changes effective at the time in one transaction
+// and records them in the price history. Changes of the deleted item details are canceled.
+// Rows are locked and skipped if locked, so concurrent schedulers do not apply a change twice.
+func (r *ItemDetailRepo) ApplyScheduledPrices(ctx context.Context, at time.Time) (int, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `SELECT id, item_detail_id, cost, price
+		FROM tbl_item_detail_scheduled_prices
+		WHERE ` + _scheduledPricePending + `
+		AND effective_from <= $1::timestamptz
+		ORDER BY effective_from, id
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.Query(ctx, q, at)
+	if err != nil {
+		return 0, err
+	}
+	var scheduledPrices []*model.ItemDetailScheduledPrice
+	for rows.Next() {
+		var scheduledPrice model.ItemDetailScheduledPrice
+		err = rows.Scan(&scheduledPrice.ID, &scheduledPrice.ItemDetailID, &scheduledPrice.Cost, &scheduledPrice.Price)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		scheduledPrices = append(scheduledPrices, &scheduledPrice)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, scheduledPrice := range scheduledPrices {
+		q = `UPDATE tbl_item_details
+			SET cost = $1,
+			price = $2,
+			updated_at = now()
+			WHERE id = $3
+			AND deleted_at IS NULL
+		`
+		var result pgconn.CommandTag
+		result, err = tx.Exec(ctx, q, scheduledPrice.Cost, scheduledPrice.Price, scheduledPrice.ItemDetailID)
+		if err != nil {
+			return 0, err
+		}
+
+		q = `UPDATE tbl_item_detail_scheduled_prices SET applied_at = now() WHERE id = $1`
+		if result.RowsAffected() == 0 {
+			q = `UPDATE tbl_item_detail_scheduled_prices SET canceled_at = now() WHERE id = $1`
+		} else {
+			applied++
+			if err = recordItemDetailPrice(ctx, tx, scheduledPrice.ItemDetailID); err != nil {
+				return 0, err
+			}
+		}
+		if _, err = tx.Exec(ctx, q, scheduledPrice.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,518 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type ModifierGroupRepo struct {
+	*postgres.Postgres
+}
+
+func NewModifierGroupRepo(pg *postgres.Postgres) *ModifierGroupRepo {
+	return &ModifierGroupRepo{pg}
+}
+
+const _modifierGroupColumns = `
+			mg.id,
+			mg.modifier_group_name,
+			mg.min_selections,
+			mg.max_selections,
+			mg.created_at,
+			mg.updated_at,
+			mg.deleted_at
+`
+
+func scanModifierGroup(row pgx.Row) (*model.ModifierGroup, error) {
+	group := model.ModifierGroup{Modifiers: []*model.Modifier{}}
+	err := row.Scan(
+		&group.ID,
+		&group.ModifierGroupName,
+		&group.MinSelections,
+		&group.MaxSelections,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+		&group.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// queryModifierGroups returns modifier groups of the query with their modifiers.
+func (r *ModifierGroupRepo) queryModifierGroups(ctx context.Context, q string, args ...interface{}) ([]*model.ModifierGroup, error) {
+	rows, err := r.Pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*model.ModifierGroup
+	for rows.Next() {
+		group, err := scanModifierGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadModifiers(ctx, groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// loadModifiers sets modifiers of the groups in one query.
+func (r *ModifierGroupRepo) loadModifiers(ctx context.Context, groups []*model.ModifierGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	groupByID := make(map[int]*model.ModifierGroup, len(groups))
+	ids := make([]int, 0, len(groups))
+	for _, group := range groups {
+		groupByID[group.ID] = group
+		ids = append(ids, group.ID)
+	}
+
+	q := `SELECT
+			id,
+			modifier_group_id,
+			modifier_name,
+			price_delta::float8,
+			sort,
+			created_at,
+			updated_at,
+			deleted_at
+		FROM tbl_modifiers
+		WHERE modifier_group_id = ANY($1)
+		AND deleted_at IS NULL
+		ORDER BY sort, id
+	`
+	rows, err := r.Pool.Query(ctx, q, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var modifier model.Modifier
+		err := rows.Scan(
+			&modifier.ID,
+			&modifier.ModifierGroupID,
+			&modifier.ModifierName,
+			&modifier.PriceDelta,
+			&modifier.Sort,
+			&modifier.CreatedAt,
+			&modifier.UpdatedAt,
+			&modifier.DeletedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		group := groupByID[modifier.ModifierGroupID]
+		group.Modifiers = append(group.Modifiers, &modifier)
+	}
+
+	return rows.Err()
+}
+
+func (r *ModifierGroupRepo) Create(ctx context.Context, group *model.ModifierGroup) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_modifier_groups (modifier_group_name, min_selections, max_selections)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q, group.ModifierGroupName, group.MinSelections, group.MaxSelections).Scan(&res)
+	if isUniqueConstraintError(err) {
+		return 0, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *ModifierGroupRepo) Get(ctx context.Context, id int) (*model.ModifierGroup, error) {
+	q := `SELECT` + _modifierGroupColumns + `
+		FROM tbl_modifier_groups AS mg
+		WHERE mg.id = $1
+		AND mg.deleted_at IS NULL
+	`
+	groups, err := r.queryModifierGroups(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	return groups[0], nil
+}
+
+func (r *ModifierGroupRepo) Exists(ctx context.Context, id int) (bool, error) {
+	var result bool
+	q := `SELECT EXISTS (SELECT 1 FROM tbl_modifier_groups WHERE id = $1 AND deleted_at IS NULL)`
+	err := r.Pool.QueryRow(ctx, q, id).Scan(&result)
+	if err != nil {
+		return false, err
+	}
+
+	return result, err
+}
+
+func (r *ModifierGroupRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.ModifierGroup, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_modifier_groups WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	q = `SELECT` + _modifierGroupColumns + `
+		FROM tbl_modifier_groups AS mg
+		WHERE mg.deleted_at IS NULL
+		AND mg.id > $1
+		ORDER BY mg.id
+		LIMIT $2
+	`
+	groups, err := r.queryModifierGroups(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups, pageInfo.NextCursor = cutPage(groups, page.Limit, func(group *model.ModifierGroup) string {
+		return encodeCursor(strconv.Itoa(group.ID))
+	})
+
+	return groups, &pageInfo, nil
+}
+
+func (r *ModifierGroupRepo) Update(ctx context.Context, id int, group *model.ModifierGroup) error {
+	q := `UPDATE tbl_modifier_groups
+		SET modifier_group_name = $1,
+		min_selections = $2,
+		max_selections = $3,
+		updated_at = now()
+		WHERE id = $4
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, group.ModifierGroupName, group.MinSelections, group.MaxSelections, id)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes modifier group with its modifiers and detaches it from item details and categories.
+func (r *ModifierGroupRepo) Delete(ctx context.Context, id int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `UPDATE tbl_modifier_groups
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := tx.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
+		return err
+	}
+
+	q = `UPDATE tbl_modifiers SET deleted_at = now() WHERE modifier_group_id = $1 AND deleted_at IS NULL`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+	q = `DELETE FROM tbl_item_detail_modifier_groups WHERE modifier_group_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+	q = `DELETE FROM tbl_category_modifier_groups WHERE modifier_group_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ModifierGroupRepo) CreateModifier(ctx context.Context, modifier *model.Modifier) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_modifiers (modifier_group_id, modifier_name, price_delta, sort)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q,
+		modifier.ModifierGroupID,
+		modifier.ModifierName,
+		modifier.PriceDelta,
+		modifier.Sort,
+	).Scan(&res)
+	if isUniqueConstraintError(err) {
+		return 0, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *ModifierGroupRepo) UpdateModifier(ctx context.Context, modifier *model.Modifier) error {
+	q := `UPDATE tbl_modifiers
+		SET modifier_name = $1,
+		price_delta = $2,
+		sort = $3,
+		updated_at = now()
+		WHERE id = $4
+		AND modifier_group_id = $5
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q,
+		modifier.ModifierName,
+		modifier.PriceDelta,
+		modifier.Sort,
+		modifier.ID,
+		modifier.ModifierGroupID,
+	)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *ModifierGroupRepo) DeleteModifier(ctx context.Context, groupID, modifierID int) error {
+	q := `UPDATE tbl_modifiers
+		SET deleted_at = now()
+		WHERE id = $1
+		AND modifier_group_id = $2
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, modifierID, groupID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// lockModifierGroup locks the modifier group row until the end of the transaction,
+// so attaching of the group to item details and categories is serialized.
+func lockModifierGroup(ctx context.Context, tx pgx.Tx, groupID int) error {
+	q := `SELECT id FROM tbl_modifier_groups WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	err := tx.QueryRow(ctx, q, groupID).Scan(&groupID)
+	if err == pgx.ErrNoRows {
+		return errs.ErrNotFound
+	}
+
+	return err
+}
+
+// AttachToItemDetail attaches modifier group to item detail.
+// It returns ErrUniqueConstraint if the group is attached to the item detail or to its category,
+// ErrNotFound if the group does not exist.
+func (r *ModifierGroupRepo) AttachToItemDetail(ctx context.Context, itemDetailID, groupID int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	if err = lockModifierGroup(ctx, tx, groupID); err != nil {
+		return err
+	}
+
+	q := `INSERT INTO tbl_item_detail_modifier_groups (item_detail_id, modifier_group_id)
+		SELECT $1, $2
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM tbl_item_details AS d
+			JOIN tbl_category_modifier_groups AS cmg ON cmg.category_id = d.category_id
+			WHERE d.id = $1
+			AND cmg.modifier_group_id = $2
+		)
+	`
+	result, err := tx.Exec(ctx, q, itemDetailID, groupID)
+	if isUniqueConstraintError(err) {
+		err = errs.ErrUniqueConstraint
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrUniqueConstraint
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ModifierGroupRepo) DetachFromItemDetail(ctx context.Context, itemDetailID, groupID int) error {
+	q := `DELETE FROM tbl_item_detail_modifier_groups WHERE item_detail_id = $1 AND modifier_group_id = $2`
+	result, err := r.Pool.Exec(ctx, q, itemDetailID, groupID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// AttachToCategory attaches modifier group to all item details of the category.
+// It returns ErrUniqueConstraint if the group is attached to the category or directly to any of its item details,
+// ErrNotFound if the group does not exist.
+func (r *ModifierGroupRepo) AttachToCategory(ctx context.Context, categoryID, groupID int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	if err = lockModifierGroup(ctx, tx, groupID); err != nil {
+		return err
+	}
+
+	q := `INSERT INTO tbl_category_modifier_groups (category_id, modifier_group_id)
+		SELECT $1, $2
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM tbl_item_details AS d
+			JOIN tbl_item_detail_modifier_groups AS idmg ON idmg.item_detail_id = d.id
+			WHERE d.category_id = $1
+			AND idmg.modifier_group_id = $2
+		)
+	`
+	result, err := tx.Exec(ctx, q, categoryID, groupID)
+	if isUniqueConstraintError(err) {
+		err = errs.ErrUniqueConstraint
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrUniqueConstraint
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ModifierGroupRepo) DetachFromCategory(ctx context.Context, categoryID, groupID int) error {
+	q := `DELETE FROM tbl_category_modifier_groups WHERE category_id = $1 AND modifier_group_id = $2`
+	result, err := r.Pool.Exec(ctx, q, categoryID, groupID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// ItemDetailModifierGroups returns modifier groups attached to the item detail or to its category, each group once.
+func (r *ModifierGroupRepo) ItemDetailModifierGroups(ctx context.Context, itemDetailID int) ([]*model.ModifierGroup, error) {
+	q := `SELECT` + _modifierGroupColumns + `
+		FROM tbl_modifier_groups AS mg
+		WHERE mg.deleted_at IS NULL
+		AND (
+			mg.id IN (SELECT modifier_group_id FROM tbl_item_detail_modifier_groups WHERE item_detail_id = $1)
+			OR mg.id IN (
+				SELECT cmg.modifier_group_id
+				FROM tbl_category_modifier_groups AS cmg
+				JOIN tbl_item_details AS d ON d.category_id = cmg.category_id
+				WHERE d.id = $1
+			)
+		)
+		ORDER BY mg.modifier_group_name, mg.id
+	`
+	return r.queryModifierGroups(ctx, q, itemDetailID)
+}
@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lmnq/test-thai/internal/errs"
+)
+
+// encodeCursor packs the key values of the last row of a page into an opaque cursor.
+func encodeCursor(values ...string) string {
+	b, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor unpacks a cursor made by encodeCursor, expecting n key values.
+func decodeCursor(cursor string, n int) ([]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+
+	var values []string
+	if err := json.Unmarshal(b, &values); err != nil || len(values) != n {
+		return nil, errs.ErrInvalidCursor
+	}
+
+	return values, nil
+}
+
+// decodeIDCursor unpacks a cursor of the lists ordered by id only.
+func decodeIDCursor(cursor string) (int, error) {
+	values, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.Atoi(values[0])
+	if err != nil {
+		return 0, errs.ErrInvalidCursor
+	}
+
+	return id, nil
+}
+
+// cutPage trims rows fetched with limit+1 to the page size
+// and returns the next cursor built from the last row, nil if there are no more rows.
+func cutPage[T any](rows []T, limit int, cursor func(T) string) ([]T, *string) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+
+	rows = rows[:limit]
+	next := cursor(rows[limit-1])
+
+	return rows, &next
+}
+
+// keysetKey is a key of the list order.
+// sqlType is used to cast the cursor value back to the key type.
+type keysetKey struct {
+	expr    string
+	sqlType string
+	desc    bool
+}
+
+// keysetSignature identifies the keys and their directions,
+// so a cursor of a list in another order is rejected instead of misread.
+func keysetSignature(keys []keysetKey) string {
+	h := fnv.New32a()
+	for _, key := range keys {
+		fmt.Fprintf(h, "%s %s %t;", key.expr, key.sqlType, key.desc)
+	}
+
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// encodeKeysetCursor packs the key values of the last row of a page with the signature of the keys.
+func encodeKeysetCursor(keys []keysetKey, values ...string) string {
+	return encodeCursor(append([]string{keysetSignature(keys)}, values...)...)
+}
+
+// decodeKeysetCursor unpacks a cursor made by encodeKeysetCursor for the same keys
+// and checks that the values can be cast to the key types.
+func decodeKeysetCursor(cursor string, keys []keysetKey) ([]string, error) {
+	values, err := decodeCursor(cursor, len(keys)+1)
+	if err != nil {
+		return nil, err
+	}
+	if values[0] != keysetSignature(keys) {
+		return nil, errs.ErrInvalidCursor
+	}
+
+	values = values[1:]
+	for i, key := range keys {
+		if !validKeysetValue(key.sqlType, values[i]) {
+			return nil, errs.ErrInvalidCursor
+		}
+	}
+
+	return values, nil
+}
+
+// validKeysetValue checks that the cursor value can be cast to the sql type of its key.
+func validKeysetValue(sqlType, value string) bool {
+	var err error
+	switch sqlType {
+	case "integer":
+		_, err = strconv.ParseInt(value, 10, 32)
+	case "numeric", "float8":
+		_, err = strconv.ParseFloat(value, 64)
+	case "timestamp":
+		_, err = time.Parse(_cursorTimeLayout, value)
+	}
+
+	return err == nil
+}
+
+// keysetOrderBy builds ORDER BY clause of the keys.
+func keysetOrderBy(keys []keysetKey) string {
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		dir := "ASC"
+		if key.desc {
+			dir = "DESC"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", key.expr, dir))
+	}
+
+	return " ORDER BY " + strings.Join(parts, ", ")
+}
+
+// keysetAfter builds condition selecting rows after the cursor values of the keys,
+// e.g. for (a ASC, b DESC): (a > $1) OR (a = $1 AND b < $2).
+// values are appended to queryParams.
+func keysetAfter(keys []keysetKey, values []string, queryParams []interface{}) (string, []interface{}) {
+	params := make([]string, 0, len(keys))
+	for i, key := range keys {
+		queryParams = append(queryParams, values[i])
+		params = append(params, fmt.Sprintf("$%d::text::%s", len(queryParams), key.sqlType))
+	}
+
+	ors := make([]string, 0, len(keys))
+	for i, key := range keys {
+		var ands []string
+		for j := 0; j < i; j++ {
+			ands = append(ands, fmt.Sprintf("%s = %s", keys[j].expr, params[j]))
+		}
+		op := ">"
+		if key.desc {
+			op = "<"
+		}
+		ands = append(ands, fmt.Sprintf("%s %s %s", key.expr, op, params[i]))
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+
+	return " AND (" + strings.Join(ors, " OR ") + ")", queryParams
+}
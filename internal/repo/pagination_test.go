@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+func TestDecodeIDCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		want    int
+		wantErr bool
+	}{
+		{"valid", encodeCursor("42"), 42, false},
+		{"not base64", "not a cursor!", 0, true},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("42")), 0, true},
+		{"json object", base64.RawURLEncoding.EncodeToString([]byte(`{"id":"42"}`)), 0, true},
+		{"too many values", encodeCursor("42", "43"), 0, true},
+		{"no values", encodeCursor(), 0, true},
+		{"not integer", encodeCursor("4x"), 0, true},
+		{"truncated", encodeCursor("42")[:3], 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeIDCursor(tt.cursor)
+			if tt.wantErr {
+				if !errors.Is(err, errs.ErrInvalidCursor) {
+					t.Errorf("decodeIDCursor(%q) error = %v, want ErrInvalidCursor", tt.cursor, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("decodeIDCursor(%q) = %v, %v, want %v", tt.cursor, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeKeysetCursor(t *testing.T) {
+	order := func(fields ...string) []keysetKey {
+		filter := &model.ItemDetailFilter{}
+		for _, field := range fields {
+			filter.OrderBy = append(filter.OrderBy, model.Order{Field: field})
+		}
+		_, keys, err := itemDetailOrder(filter, "")
+		if err != nil {
+			t.Fatalf("item detail order: %v", err)
+		}
+		return keys
+	}
+	byPrice := order(model.ItemDetailOrderPrice)
+	byPriceDesc := slices.Clone(byPrice)
+	byPriceDesc[0].desc = true
+	bySort := order(model.ItemDetailOrderSort)
+	byName := order(model.ItemDetailOrderItemName)
+	byCreatedAt := order(model.ItemDetailOrderCreatedAt)
+
+	priceCursor := encodeKeysetCursor(byPrice, "12.5", "7")
+	tampered := []byte(`["` + keysetSignature(byPrice) + `","12.5","7","8"]`)
+
+	tests := []struct {
+		name    string
+		cursor  string
+		keys    []keysetKey
+		want    []string
+		wantErr bool
+	}{
+		{"valid", priceCursor, byPrice, []string{"12.5", "7"}, false},
+		{"valid timestamp", encodeKeysetCursor(byCreatedAt, "2024-05-01 10:00:00.5", "7"), byCreatedAt, []string{"2024-05-01 10:00:00.5", "7"}, false},
+		{"not base64", "%%%", byPrice, nil, true},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("12.5,7")), byPrice, nil, true},
+		{"tampered value count", base64.RawURLEncoding.EncodeToString(tampered), byPrice, nil, true},
+		{"without signature", encodeCursor("12.5", "7"), byPrice, nil, true},
+		{"other order field", priceCursor, byName, nil, true},
+		{"other order field of the type", encodeKeysetCursor(byName, "12", "7"), bySort, nil, true},
+		{"other order direction", priceCursor, byPriceDesc, nil, true},
+		{"other order field count", priceCursor, order(model.ItemDetailOrderPrice, model.ItemDetailOrderSort), nil, true},
+		{"invalid integer", encodeKeysetCursor(bySort, "first", "7"), bySort, nil, true},
+		{"invalid numeric", encodeKeysetCursor(byPrice, "12,5", "7"), byPrice, nil, true},
+		{"invalid timestamp", encodeKeysetCursor(byCreatedAt, "yesterday", "7"), byCreatedAt, nil, true},
+		{"invalid id", encodeKeysetCursor(byPrice, "12.5", "7.5"), byPrice, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeKeysetCursor(tt.cursor, tt.keys)
+			if tt.wantErr {
+				if !errors.Is(err, errs.ErrInvalidCursor) {
+					t.Errorf("decodeKeysetCursor error = %v, want ErrInvalidCursor", err)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(got, tt.want) {
+				t.Errorf("decodeKeysetCursor = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysetAfter(t *testing.T) {
+	keys := []keysetKey{{"a", "integer", false}, {"b", "text", true}}
+
+	where, params := keysetAfter(keys, []string{"1", "x"}, []interface{}{"q"})
+
+	want := " AND ((a > $2::text::integer) OR (a = $2::text::integer AND b < $3::text::text))"
+	if where != want {
+		t.Errorf("keysetAfter where = %q, want %q", where, want)
+	}
+	if !slices.Equal(params, []interface{}{"q", "1", "x"}) {
+		t.Errorf("keysetAfter params = %v", params)
+	}
+}
@@ -0,0 +1,285 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type PromotionRepo struct {
+	*postgres.Postgres
+}
+
+func NewPromotionRepo(pg *postgres.Postgres) *PromotionRepo {
+	return &PromotionRepo{pg}
+}
+
+const _promotionColumns = `
+			id,
+			promotion_name,
+			discount_type,
+			discount_value::float8,
+			currency,
+			buy_quantity,
+			get_quantity,
+			target_type,
+			target_id,
+			starts_at,
+			ends_at,
+			weekdays,
+			stackable,
+			priority,
+			created_at,
+			updated_at,
+			deleted_at
+`
+
+func scanPromotion(row pgx.Row) (*model.Promotion, error) {
+	var promotion model.Promotion
+	err := row.Scan(
+		&promotion.ID,
+		&promotion.PromotionName,
+		&promotion.DiscountType,
+		&promotion.DiscountValue,
+		&promotion.Currency,
+		&promotion.BuyQuantity,
+		&promotion.GetQuantity,
+		&promotion.TargetType,
+		&promotion.TargetID,
+		&promotion.StartsAt,
+		&promotion.EndsAt,
+		&promotion.Weekdays,
+		&promotion.Stackable,
+		&promotion.Priority,
+		&promotion.CreatedAt,
+		&promotion.UpdatedAt,
+		&promotion.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &promotion, nil
+}
+
+func (r *PromotionRepo) Create(ctx context.Context, promotion *model.Promotion) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_promotions (
+			promotion_name,
+			discount_type,
+			discount_value,
+			currency,
+			buy_quantity,
+			get_quantity,
+			target_type,
+			target_id,
+			starts_at,
+			ends_at,
+			weekdays,
+			stackable,
+			priority
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::timestamptz, $10::timestamptz, $11, $12, $13)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q,
+		promotion.PromotionName,
+		promotion.DiscountType,
+		promotion.DiscountValue,
+		promotion.Currency,
+		promotion.BuyQuantity,
+		promotion.GetQuantity,
+		promotion.TargetType,
+		promotion.TargetID,
+		promotion.StartsAt,
+		promotion.EndsAt,
+		promotion.Weekdays,
+		promotion.Stackable,
+		promotion.Priority,
+	).Scan(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *PromotionRepo) Get(ctx context.Context, id int) (*model.Promotion, error) {
+	q := `SELECT` + _promotionColumns + `
+		FROM tbl_promotions
+		WHERE id = $1
+		AND deleted_at IS NULL
+	`
+	promotion, err := scanPromotion(r.Pool.QueryRow(ctx, q, id))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+func (r *PromotionRepo) GetAll(ctx context.Context, filter *model.PromotionFilter, page *model.Page) ([]*model.Promotion, *model.PageInfo, error) {
+	where := " WHERE deleted_at IS NULL"
+	var queryParams []interface{}
+	if filter.TargetType != nil {
+		queryParams = append(queryParams, *filter.TargetType)
+		where += fmt.Sprintf(" AND target_type = $%d", len(queryParams))
+	}
+	if filter.TargetID != nil {
+		queryParams = append(queryParams, *filter.TargetID)
+		where += fmt.Sprintf(" AND target_id = $%d", len(queryParams))
+	}
+
+	var pageInfo model.PageInfo
+	q := "SELECT count(*) FROM tbl_promotions" + where
+	err := r.Pool.QueryRow(ctx, q, queryParams...).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	queryParams = append(queryParams, afterID)
+	where += fmt.Sprintf(" AND id > $%d", len(queryParams))
+	queryParams = append(queryParams, page.Limit+1)
+
+	var promotions []*model.Promotion
+	q = `SELECT` + _promotionColumns + `
+		FROM tbl_promotions` + where + fmt.Sprintf(" ORDER BY id LIMIT $%d", len(queryParams))
+	rows, err := r.Pool.Query(ctx, q, queryParams...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		promotions = append(promotions, promotion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	promotions, pageInfo.NextCursor = cutPage(promotions, page.Limit, func(promotion *model.Promotion) string {
+		return encodeCursor(strconv.Itoa(promotion.ID))
+	})
+
+	return promotions, &pageInfo, nil
+}
+
+// Active returns promotions of the item, the category or the group active at the time,
+// ordered by priority and id. Weekdays are not checked, they depend on the time zone of the caller.
+func (r *PromotionRepo) Active(ctx context.Context, itemID, categoryID, groupID int, at time.Time) ([]*model.Promotion, error) {
+	q := `SELECT` + _promotionColumns + `
+		FROM tbl_promotions
+		WHERE deleted_at IS NULL
+		AND (
+			(target_type = 'item' AND target_id = $1)
+			OR (target_type = 'category' AND target_id = $2)
+			OR (target_type = 'group' AND target_id = $3)
+		)
+		AND (starts_at IS NULL OR starts_at <= $4::timestamptz)
+		AND (ends_at IS NULL OR ends_at > $4::timestamptz)
+		ORDER BY priority, id
+	`
+	rows, err := r.Pool.Query(ctx, q, itemID, categoryID, groupID, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promotions []*model.Promotion
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		promotions = append(promotions, promotion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+func (r *PromotionRepo) Update(ctx context.Context, id int, promotion *model.Promotion) error {
+	q := `UPDATE tbl_promotions
+		SET promotion_name = $1,
+		discount_type = $2,
+		discount_value = $3,
+		currency = $4,
+		buy_quantity = $5,
+		get_quantity = $6,
+		target_type = $7,
+		target_id = $8,
+		starts_at = $9::timestamptz,
+		ends_at = $10::timestamptz,
+		weekdays = $11,
+		stackable = $12,
+		priority = $13,
+		updated_at = now()
+		WHERE id = $14
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q,
+		promotion.PromotionName,
+		promotion.DiscountType,
+		promotion.DiscountValue,
+		promotion.Currency,
+		promotion.BuyQuantity,
+		promotion.GetQuantity,
+		promotion.TargetType,
+		promotion.TargetID,
+		promotion.StartsAt,
+		promotion.EndsAt,
+		promotion.Weekdays,
+		promotion.Stackable,
+		promotion.Priority,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *PromotionRepo) Delete(ctx context.Context, id int) error {
+	q := `UPDATE tbl_promotions
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/lmnq/test-thai/database/postgres"
 	"github.com/lmnq/test-thai/internal/model"
@@ -12,52 +13,171 @@ type Repo struct {
 	Category
 	Group
 	ItemDetail
+	ExchangeRate
+	TaxProfile
+	Promotion
+	ModifierGroup
+	Bundle
+	Translation
+	Tag
+	Image
+	Stock
 }
 
 func New(pg *postgres.Postgres) *Repo {
 	return &Repo{
-		Item:       NewItemRepo(pg),
-		Category:   NewCategoryRepo(pg),
-		Group:      NewGroupRepo(pg),
-		ItemDetail: NewItemDetailRepo(pg),
+		Item:          NewItemRepo(pg),
+		Category:      NewCategoryRepo(pg),
+		Group:         NewGroupRepo(pg),
+		ItemDetail:    NewItemDetailRepo(pg),
+		ExchangeRate:  NewExchangeRateRepo(pg),
+		TaxProfile:    NewTaxProfileRepo(pg),
+		Promotion:     NewPromotionRepo(pg),
+		ModifierGroup: NewModifierGroupRepo(pg),
+		Bundle:        NewBundleRepo(pg),
+		Translation:   NewTranslationRepo(pg),
+		Tag:           NewTagRepo(pg),
+		Image:         NewImageRepo(pg),
+		Stock:         NewStockRepo(pg),
 	}
 }
 
 // repo interfaces -.
 type (
 	Item interface {
-		Create(ctx context.Context, name string) (int, error)      // create new item
-		Get(ctx context.Context, id int) (*model.Item, error)      // get item by id
-		GetIDByName(ctx context.Context, name string) (int, error) // get item id by name
-		GetAll(ctx context.Context) ([]*model.Item, error)         // get all items
-		Update(ctx context.Context, id int, name string) error     // update item by id
-		Delete(ctx context.Context, id int) error                  // delete item by id
+		Create(ctx context.Context, name string) (int, error)                                                           // create new item
+		Get(ctx context.Context, id int) (*model.Item, error)                                                           // get item by id
+		GetIDByName(ctx context.Context, name string) (int, error)                                                      // get item id by name
+		Exists(ctx context.Context, id int) (bool, error)                                                               // check if item exists
+		GetAll(ctx context.Context, filter *model.ItemFilter, page *model.Page) ([]*model.Item, *model.PageInfo, error) // get page of items by filter
+		Update(ctx context.Context, id int, name string) error                                                          // update item by id
+		Delete(ctx context.Context, id int) error                                                                       // delete item by id
 	}
 
 	Category interface {
-		Create(ctx context.Context, name string) (int, error)     // create new category
-		Get(ctx context.Context, id int) (*model.Category, error) // get category by id
-		Exists(ctx context.Context, id int) (bool, error)         // check if category exists
-		GetAll(ctx context.Context) ([]*model.Category, error)    // get all categories
-		Update(ctx context.Context, id int, name string) error    // update category by id
-		Delete(ctx context.Context, id int) error                 // delete category by id
+		Create(ctx context.Context, name string) (int, error)                                     // create new category
+		Get(ctx context.Context, id int) (*model.Category, error)                                 // get category by id
+		Exists(ctx context.Context, id int) (bool, error)                                         // check if category exists
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Category, *model.PageInfo, error) // get page of categories
+		GetTree(ctx context.Context) ([]*model.Category, error)                                   // get all categories to build the tree of
+		Update(ctx context.Context, id int, name string) error                                    // update category by id
+		Delete(ctx context.Context, id int) error                                                 // delete category by id and move its sub-categories to its parent
+		SetTaxProfile(ctx context.Context, id int, taxProfileID *int) error                       // attach tax profile to category, nil detaches it
+		SetParent(ctx context.Context, id int, parentID *int) error                               // move category under parent, nil makes it top level
 	}
 
 	Group interface {
-		Create(ctx context.Context, name string) (int, error)  // create new group
-		Get(ctx context.Context, id int) (*model.Group, error) // get group by id
-		Exists(ctx context.Context, id int) (bool, error)      // check if group exists
-		GetAll(ctx context.Context) ([]*model.Group, error)    // get all groups
-		Update(ctx context.Context, id int, name string) error // update group by id
-		Delete(ctx context.Context, id int) error              // delete group by id
+		Create(ctx context.Context, name string) (int, error)                                  // create new group
+		Get(ctx context.Context, id int) (*model.Group, error)                                 // get group by id
+		Exists(ctx context.Context, id int) (bool, error)                                      // check if group exists
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Group, *model.PageInfo, error) // get page of groups
+		Update(ctx context.Context, id int, name string) error                                 // update group by id
+		Delete(ctx context.Context, id int) error                                              // delete group by id
+		SetTaxProfile(ctx context.Context, id int, taxProfileID *int) error                    // attach tax profile to group, nil detaches it
 	}
 
 	ItemDetail interface {
-		Create(ctx context.Context, itemDetail *model.ItemDetail, itemName string) (int, error)            // create new item (if needed) and new item detail
-		Get(ctx context.Context, id int) (*model.ItemDetailView, error)                                    // get item detail by id
-		Exists(ctx context.Context, id int) (bool, error)                                                  // check if item detail exists
-		GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter) ([]*model.ItemDetailView, error) // get item detail list by filter
-		Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) error           // update item detail by id
-		Delete(ctx context.Context, id int) error                                                          // delete item detail by id
+		Create(ctx context.Context, itemDetail *model.ItemDetail, itemName string) (int, error)                                                                           // create new item (if needed) and new item detail
+		Get(ctx context.Context, id int) (*model.ItemDetailView, error)                                                                                                   // get item detail by id
+		GetByBarcode(ctx context.Context, barcode string) (*model.ItemDetailView, error)                                                                                  // get item detail by EAN-13 or UPC-A barcode
+		Exists(ctx context.Context, id int) (bool, error)                                                                                                                 // check if item detail exists
+		GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemDetailView, *model.PageInfo, error)                             // get page of item detail list by filter
+		GetAllGrouped(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemVariants, *model.PageInfo, error)                              // get page of items with their item details by filter
+		Export(ctx context.Context, filter *model.ItemDetailFilter, locale string, fn func(*model.ItemDetailView) error) error                                            // pass item detail list by filter with names in the locale to fn row by row
+		Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) error                                                                          // update item detail by id
+		Patch(ctx context.Context, id int, patch *model.ItemDetailPatch) error                                                                                            // partially update item detail by id
+		Delete(ctx context.Context, id int) error                                                                                                                         // delete item detail by id
+		Bulk(ctx context.Context, ops []*model.ItemDetailBulkOp, atomic bool) (ids []int, opErrs []error, err error)                                                      // apply create, update and delete operations in one transaction
+		Import(ctx context.Context, rows []*model.ItemDetailImportRow, commit bool) (results []*model.ItemDetailImportResult, rowErrs []error, committed bool, err error) // create or update item details of the import rows in one transaction
+		PriceHistory(ctx context.Context, id int, filter *model.PriceHistoryFilter) ([]*model.ItemDetailPrice, error)                                                     // get cost and price changes of item detail
+		PriceAt(ctx context.Context, id int, at time.Time) (*model.ItemDetailPrice, error)                                                                                // get cost and price of item detail at the time
+		SchedulePrice(ctx context.Context, scheduledPrice *model.ItemDetailScheduledPrice) (int, error)                                                                   // schedule cost and price change of item detail
+		ScheduledPrices(ctx context.Context, id int) ([]*model.ItemDetailScheduledPrice, error)                                                                           // get pending cost and price changes of item detail
+		CancelScheduledPrice(ctx context.Context, id, scheduledPriceID int) error                                                                                         // cancel pending cost and price change of item detail
+		ApplyScheduledPrices(ctx context.Context, at time.Time) (int, error)                                                                                              // apply pending cost and price changes effective at the time
+	}
+
+	ExchangeRate interface {
+		Create(ctx context.Context, rate *model.ExchangeRate) (int, error)                            // create new exchange rate
+		Get(ctx context.Context, id int) (*model.ExchangeRate, error)                                 // get exchange rate by id
+		GetByCurrencies(ctx context.Context, base, quote string) (*model.ExchangeRate, error)         // get exchange rate of the currency pair
+		GetAll(ctx context.Context, page *model.Page) ([]*model.ExchangeRate, *model.PageInfo, error) // get page of exchange rates
+		Update(ctx context.Context, id int, rate *model.ExchangeRate) error                           // update exchange rate by id
+		Delete(ctx context.Context, id int) error                                                     // delete exchange rate by id
+	}
+
+	TaxProfile interface {
+		Create(ctx context.Context, profile *model.TaxProfile) (int, error)                         // create new tax profile
+		Get(ctx context.Context, id int) (*model.TaxProfile, error)                                 // get tax profile by id
+		Exists(ctx context.Context, id int) (bool, error)                                           // check if tax profile exists
+		GetAll(ctx context.Context, page *model.Page) ([]*model.TaxProfile, *model.PageInfo, error) // get page of tax profiles
+		Update(ctx context.Context, id int, profile *model.TaxProfile) error                        // update tax profile by id
+		Delete(ctx context.Context, id int) error                                                   // delete tax profile by id and detach it from categories and groups
+	}
+
+	Promotion interface {
+		Create(ctx context.Context, promotion *model.Promotion) (int, error)                                                      // create new promotion
+		Get(ctx context.Context, id int) (*model.Promotion, error)                                                                // get promotion by id
+		GetAll(ctx context.Context, filter *model.PromotionFilter, page *model.Page) ([]*model.Promotion, *model.PageInfo, error) // get page of promotions by filter
+		Active(ctx context.Context, itemID, categoryID, groupID int, at time.Time) ([]*model.Promotion, error)                    // get promotions of the targets active at the time
+		Update(ctx context.Context, id int, promotion *model.Promotion) error                                                     // update promotion by id
+		Delete(ctx context.Context, id int) error                                                                                 // delete promotion by id
+	}
+
+	ModifierGroup interface {
+		Create(ctx context.Context, group *model.ModifierGroup) (int, error)                            // create new modifier group
+		Get(ctx context.Context, id int) (*model.ModifierGroup, error)                                  // get modifier group with modifiers by id
+		Exists(ctx context.Context, id int) (bool, error)                                               // check if modifier group exists
+		GetAll(ctx context.Context, page *model.Page) ([]*model.ModifierGroup, *model.PageInfo, error)  // get page of modifier groups with modifiers
+		Update(ctx context.Context, id int, group *model.ModifierGroup) error                           // update modifier group by id
+		Delete(ctx context.Context, id int) error                                                       // delete modifier group with modifiers by id and detach it
+		CreateModifier(ctx context.Context, modifier *model.Modifier) (int, error)                      // create new modifier of modifier group
+		UpdateModifier(ctx context.Context, modifier *model.Modifier) error                             // update modifier of modifier group
+		DeleteModifier(ctx context.Context, groupID, modifierID int) error                              // delete modifier of modifier group
+		AttachToItemDetail(ctx context.Context, itemDetailID, groupID int) error                        // attach modifier group to item detail
+		DetachFromItemDetail(ctx context.Context, itemDetailID, groupID int) error                      // detach modifier group from item detail
+		AttachToCategory(ctx context.Context, categoryID, groupID int) error                            // attach modifier group to category
+		DetachFromCategory(ctx context.Context, categoryID, groupID int) error                          // detach modifier group from category
+		ItemDetailModifierGroups(ctx context.Context, itemDetailID int) ([]*model.ModifierGroup, error) // get modifier groups of item detail and its category
+	}
+
+	Bundle interface {
+		Create(ctx context.Context, bundle *model.Bundle) (int, error)                                                      // create new bundle with components
+		Get(ctx context.Context, id int) (*model.Bundle, error)                                                             // get bundle with components by id
+		GetAll(ctx context.Context, filter *model.BundleFilter, page *model.Page) ([]*model.Bundle, *model.PageInfo, error) // get page of bundles with components by filter
+		Update(ctx context.Context, id int, bundle *model.Bundle) error                                                     // update bundle and replace its components by id
+		Delete(ctx context.Context, id int) error                                                                           // delete bundle by id
+	}
+
+	Translation interface {
+		Set(ctx context.Context, entity string, id int, translation *model.Translation) error // create or replace translation of item, category or group name
+		GetAll(ctx context.Context, entity string, id int) ([]*model.Translation, error)      // get translations of item, category or group name
+		Names(ctx context.Context, entity, locale string, ids []int) (map[int]string, error)  // get names of items, categories or groups in the locale by id
+		Delete(ctx context.Context, entity string, id int, locale string) error               // delete translation of item, category or group name
+	}
+
+	Tag interface {
+		Create(ctx context.Context, name string) (int, error)                                // create new tag
+		Get(ctx context.Context, id int) (*model.Tag, error)                                 // get tag by id
+		Exists(ctx context.Context, id int) (bool, error)                                    // check if tag exists
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Tag, *model.PageInfo, error) // get page of tags
+		Update(ctx context.Context, id int, name string) error                               // update tag by id
+		Delete(ctx context.Context, id int) error                                            // delete tag by id and remove it from items
+		AttachToItem(ctx context.Context, itemID, tagID int) error                           // add tag to item
+		DetachFromItem(ctx context.Context, itemID, tagID int) error                         // remove tag from item
+	}
+
+	Image interface {
+		Create(ctx context.Context, image *model.ItemImage) (int, error)      // create new item image
+		Get(ctx context.Context, itemID, id int) (*model.ItemImage, error)    // get image of item by id
+		GetAll(ctx context.Context, itemID int) ([]*model.ItemImage, error)   // get images of item in upload order
+		Delete(ctx context.Context, itemID, id int) (*model.ItemImage, error) // delete image of item by id and return it
+	}
+
+	Stock interface {
+		CreateMovement(ctx context.Context, movement *model.StockMovement, allowNegative bool) error                        // record stock movement of item detail with the balance after it
+		Balance(ctx context.Context, itemDetailID int) (*model.StockBalance, error)                                         // get on-hand quantity of item detail
+		Balances(ctx context.Context, page *model.Page) ([]*model.StockBalance, *model.PageInfo, error)                     // get page of balances of item details having movements
+		Movements(ctx context.Context, itemDetailID int, page *model.Page) ([]*model.StockMovement, *model.PageInfo, error) // get page of stock movements of item detail, latest first
 	}
 )
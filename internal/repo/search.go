@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern returns ILIKE pattern matching the substring s.
+func containsPattern(s string) string {
+	return "%" + _likeEscaper.Replace(s) + "%"
+}
+
+// searchMatch builds condition matching the column by substring (pattern)
+// or by trigram word similarity to q. Both use the pg_trgm GIN index of the column.
+func searchMatch(column, q, pattern string) string {
+	return fmt.Sprintf("(%s ILIKE %s OR %s <%% %s)", column, pattern, q, column)
+}
+
+// searchRank builds relevance of the column to q, substring matches rank above the similar ones.
+func searchRank(column, q, pattern string) string {
+	return fmt.Sprintf("(word_similarity(%s, %s) + CASE WHEN %s ILIKE %s THEN 1 ELSE 0 END)", q, column, column, pattern)
+}
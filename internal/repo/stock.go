@@ -0,0 +1,245 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type StockRepo struct {
+	*postgres.Postgres
+}
+
+func NewStockRepo(pg *postgres.Postgres) *StockRepo {
+	return &StockRepo{pg}
+}
+
+const _stockMovementColumns = `
+			id,
+			item_detail_id,
+			movement_type,
+			quantity,
+			balance_after,
+			note,
+			created_at
+`
+
+// last movement of the item detail in itd, its balance_after is the current balance
+const _lastStockMovementJoin = `
+		JOIN LATERAL (
+			SELECT m.balance_after, m.created_at
+			FROM tbl_stock_movements AS m
+			WHERE m.item_detail_id = itd.id
+			ORDER BY m.id DESC
+			LIMIT 1
+		) AS last ON true
+`
+
+func scanStockMovement(row pgx.Row) (*model.StockMovement, error) {
+	var movement model.StockMovement
+	err := row.Scan(
+		&movement.ID,
+		&movement.ItemDetailID,
+		&movement.Type,
+		&movement.Quantity,
+		&movement.BalanceAfter,
+		&movement.Note,
+		&movement.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &movement, nil
+}
+
+// CreateMovement records the movement with the balance after it and sets its id, balance and time.
+// The item detail row is locked until the commit, so concurrent movements of the item detail are serialized
+// and each one adds to the balance after the previous one.
+// If the balance goes below zero and it is not allowed, the movement is rolled back with ErrNegativeStock.
+func (r *StockRepo) CreateMovement(ctx context.Context, movement *model.StockMovement, allowNegative bool) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `SELECT id FROM tbl_item_details WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	err = tx.QueryRow(ctx, q, movement.ItemDetailID).Scan(&movement.ItemDetailID)
+	if err == pgx.ErrNoRows {
+		err = errs.ErrNotFound
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	// the balance is added up in numeric, so the float quantities do not accumulate rounding errors
+	q = `INSERT INTO tbl_stock_movements (item_detail_id, movement_type, quantity, balance_after, note)
+		SELECT $1, $2, $3::numeric, COALESCE((
+			SELECT m.balance_after
+			FROM tbl_stock_movements AS m
+			WHERE m.item_detail_id = $1
+			ORDER BY m.id DESC
+			LIMIT 1
+		), 0) + $3::numeric, $4
+		RETURNING id, quantity, balance_after, created_at
+	`
+	err = tx.QueryRow(ctx, q,
+		movement.ItemDetailID,
+		movement.Type,
+		movement.Quantity,
+		movement.Note,
+	).Scan(&movement.ID, &movement.Quantity, &movement.BalanceAfter, &movement.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if !allowNegative && movement.BalanceAfter < 0 {
+		err = errs.ErrNegativeStock
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Balance returns the on-hand quantity of the item detail, zero if it has no movements.
+func (r *StockRepo) Balance(ctx context.Context, itemDetailID int) (*model.StockBalance, error) {
+	var balance model.StockBalance
+	q := `SELECT itd.id, COALESCE(last.balance_after, 0), last.created_at
+		FROM tbl_item_details AS itd
+		LEFT` + _lastStockMovementJoin + `
+		WHERE itd.id = $1 AND itd.deleted_at IS NULL
+	`
+	err := r.Pool.QueryRow(ctx, q, itemDetailID).Scan(&balance.ItemDetailID, &balance.Quantity, &balance.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// Balances returns page of balances of the item details having movements, ordered by item detail id.
+func (r *StockRepo) Balances(ctx context.Context, page *model.Page) ([]*model.StockBalance, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*)
+		FROM tbl_item_details AS itd
+		WHERE itd.deleted_at IS NULL
+		AND EXISTS (SELECT 1 FROM tbl_stock_movements AS m WHERE m.item_detail_id = itd.id)
+	`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last item detail id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var balances []*model.StockBalance
+	q = `SELECT itd.id, last.balance_after, last.created_at
+		FROM tbl_item_details AS itd` + _lastStockMovementJoin + `
+		WHERE itd.deleted_at IS NULL
+		AND itd.id > $1
+		ORDER BY itd.id
+		LIMIT $2
+	`
+	rows, err := r.Pool.Query(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var balance model.StockBalance
+		if err := rows.Scan(&balance.ItemDetailID, &balance.Quantity, &balance.UpdatedAt); err != nil {
+			return nil, nil, err
+		}
+
+		balances = append(balances, &balance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	balances, pageInfo.NextCursor = cutPage(balances, page.Limit, func(balance *model.StockBalance) string {
+		return encodeCursor(strconv.Itoa(balance.ItemDetailID))
+	})
+
+	return balances, &pageInfo, nil
+}
+
+// Movements returns page of movements of the item detail, the latest first.
+func (r *StockRepo) Movements(ctx context.Context, itemDetailID int, page *model.Page) ([]*model.StockMovement, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_stock_movements WHERE item_detail_id = $1`
+	err := r.Pool.QueryRow(ctx, q, itemDetailID).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id descending, cursor holds the last id of the previous page
+	beforeID := 0
+	if page.Cursor != "" {
+		beforeID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var movements []*model.StockMovement
+	q = `SELECT` + _stockMovementColumns + `
+		FROM tbl_stock_movements
+		WHERE item_detail_id = $1
+		AND ($2 = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3
+	`
+	rows, err := r.Pool.Query(ctx, q, itemDetailID, beforeID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movement, err := scanStockMovement(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		movements = append(movements, movement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	movements, pageInfo.NextCursor = cutPage(movements, page.Limit, func(movement *model.StockMovement) string {
+		return encodeCursor(strconv.Itoa(movement.ID))
+	})
+
+	return movements, &pageInfo, nil
+}
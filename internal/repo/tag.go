@@ -0,0 +1,249 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type TagRepo struct {
+	*postgres.Postgres
+}
+
+func NewTagRepo(pg *postgres.Postgres) *TagRepo {
+	return &TagRepo{pg}
+}
+
+const _tagColumns = `
+			id,
+			tag_name,
+			created_at,
+			updated_at,
+			deleted_at
+`
+
+func scanTag(row pgx.Row) (*model.Tag, error) {
+	var tag model.Tag
+	err := row.Scan(
+		&tag.ID,
+		&tag.TagName,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+		&tag.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// tag names of the item ordered by name, of the items in tbl_items and in the item detail view
+const (
+	_itemTagsSelect = `ARRAY(
+				SELECT t.tag_name
+				FROM tbl_item_tags AS it
+				JOIN tbl_tags AS t ON t.id = it.tag_id AND t.deleted_at IS NULL
+				WHERE it.item_id = `
+	_itemTagsColumn       = _itemTagsSelect + `tbl_items.id ORDER BY t.tag_name)`
+	_itemDetailTagsColumn = _itemTagsSelect + `itd.item_id ORDER BY t.tag_name)`
+)
+
+// tagsMatch builds condition matching the item with id by the tags param n,
+// the item has any or all of the tags by mode. The tags must be distinct.
+func tagsMatch(id string, n int, mode string) string {
+	tagged := fmt.Sprintf(`
+				FROM tbl_item_tags AS it
+				JOIN tbl_tags AS t ON t.id = it.tag_id
+				WHERE it.item_id = %s
+				AND t.deleted_at IS NULL
+				AND t.tag_name = ANY($%d)`, id, n)
+	if mode == model.TagsModeAll {
+		return fmt.Sprintf("(SELECT count(*) %s) = cardinality($%d::text[])", tagged, n)
+	}
+
+	return fmt.Sprintf("EXISTS (SELECT 1 %s)", tagged)
+}
+
+func (r *TagRepo) Create(ctx context.Context, name string) (int, error) {
+	var res int
+	q := "INSERT INTO tbl_tags (tag_name) VALUES ($1) RETURNING id"
+	err := r.Pool.QueryRow(ctx, q, name).Scan(&res)
+	if isUniqueConstraintError(err) {
+		return 0, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *TagRepo) Get(ctx context.Context, id int) (*model.Tag, error) {
+	q := `SELECT` + _tagColumns + `
+		FROM tbl_tags
+		WHERE id = $1
+		AND deleted_at IS NULL
+	`
+	tag, err := scanTag(r.Pool.QueryRow(ctx, q, id))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (r *TagRepo) Exists(ctx context.Context, id int) (bool, error) {
+	var result bool
+	q := `SELECT EXISTS (SELECT 1 FROM tbl_tags WHERE id = $1 AND deleted_at IS NULL)`
+	err := r.Pool.QueryRow(ctx, q, id).Scan(&result)
+	if err != nil {
+		return false, err
+	}
+
+	return result, err
+}
+
+func (r *TagRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.Tag, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_tags WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var tags []*model.Tag
+	q = `SELECT` + _tagColumns + `
+		FROM tbl_tags
+		WHERE deleted_at IS NULL
+		AND id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+	rows, err := r.Pool.Query(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	tags, pageInfo.NextCursor = cutPage(tags, page.Limit, func(tag *model.Tag) string {
+		return encodeCursor(strconv.Itoa(tag.ID))
+	})
+
+	return tags, &pageInfo, nil
+}
+
+func (r *TagRepo) Update(ctx context.Context, id int, name string) error {
+	q := `UPDATE tbl_tags
+		SET tag_name = $1,
+		updated_at = now()
+		WHERE id = $2
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q, name, id)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes tag and removes it from its items.
+func (r *TagRepo) Delete(ctx context.Context, id int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `UPDATE tbl_tags
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := tx.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
+		return err
+	}
+
+	q = `DELETE FROM tbl_item_tags WHERE tag_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TagRepo) AttachToItem(ctx context.Context, itemID, tagID int) error {
+	q := `INSERT INTO tbl_item_tags (item_id, tag_id) VALUES ($1, $2)`
+	_, err := r.Pool.Exec(ctx, q, itemID, tagID)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+
+	return err
+}
+
+func (r *TagRepo) DetachFromItem(ctx context.Context, itemID, tagID int) error {
+	q := `DELETE FROM tbl_item_tags WHERE item_id = $1 AND tag_id = $2`
+	result, err := r.Pool.Exec(ctx, q, itemID, tagID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type TaxProfileRepo struct {
+	*postgres.Postgres
+}
+
+func NewTaxProfileRepo(pg *postgres.Postgres) *TaxProfileRepo {
+	return &TaxProfileRepo{pg}
+}
+
+const _taxProfileColumns = `
+			id,
+			profile_name,
+			vat_percent,
+			service_charge_percent,
+			price_includes_tax,
+			created_at,
+			updated_at,
+			deleted_at
+`
+
+func scanTaxProfile(row pgx.Row) (*model.TaxProfile, error) {
+	var profile model.TaxProfile
+	err := row.Scan(
+		&profile.ID,
+		&profile.ProfileName,
+		&profile.VATPercent,
+		&profile.ServiceChargePercent,
+		&profile.PriceIncludesTax,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+		&profile.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (r *TaxProfileRepo) Create(ctx context.Context, profile *model.TaxProfile) (int, error) {
+	var res int
+	q := `INSERT INTO tbl_tax_profiles (profile_name, vat_percent, service_charge_percent, price_includes_tax)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	err := r.Pool.QueryRow(ctx, q,
+		profile.ProfileName,
+		profile.VATPercent,
+		profile.ServiceChargePercent,
+		profile.PriceIncludesTax,
+	).Scan(&res)
+	if isUniqueConstraintError(err) {
+		return 0, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (r *TaxProfileRepo) Get(ctx context.Context, id int) (*model.TaxProfile, error) {
+	q := `SELECT` + _taxProfileColumns + `
+		FROM tbl_tax_profiles
+		WHERE id = $1
+		AND deleted_at IS NULL
+	`
+	profile, err := scanTaxProfile(r.Pool.QueryRow(ctx, q, id))
+	if err == pgx.ErrNoRows {
+		return nil, errs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (r *TaxProfileRepo) Exists(ctx context.Context, id int) (bool, error) {
+	var result bool
+	q := `SELECT EXISTS (SELECT 1 FROM tbl_tax_profiles WHERE id = $1 AND deleted_at IS NULL)`
+	err := r.Pool.QueryRow(ctx, q, id).Scan(&result)
+	if err != nil {
+		return false, err
+	}
+
+	return result, err
+}
+
+func (r *TaxProfileRepo) GetAll(ctx context.Context, page *model.Page) ([]*model.TaxProfile, *model.PageInfo, error) {
+	var pageInfo model.PageInfo
+	q := `SELECT count(*) FROM tbl_tax_profiles WHERE deleted_at IS NULL`
+	err := r.Pool.QueryRow(ctx, q).Scan(&pageInfo.Total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// keyset pagination by id, cursor holds the last id of the previous page
+	afterID := 0
+	if page.Cursor != "" {
+		afterID, err = decodeIDCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var profiles []*model.TaxProfile
+	q = `SELECT` + _taxProfileColumns + `
+		FROM tbl_tax_profiles
+		WHERE deleted_at IS NULL
+		AND id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+	rows, err := r.Pool.Query(ctx, q, afterID, page.Limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		profile, err := scanTaxProfile(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	profiles, pageInfo.NextCursor = cutPage(profiles, page.Limit, func(profile *model.TaxProfile) string {
+		return encodeCursor(strconv.Itoa(profile.ID))
+	})
+
+	return profiles, &pageInfo, nil
+}
+
+func (r *TaxProfileRepo) Update(ctx context.Context, id int, profile *model.TaxProfile) error {
+	q := `UPDATE tbl_tax_profiles
+		SET profile_name = $1,
+		vat_percent = $2,
+		service_charge_percent = $3,
+		price_includes_tax = $4,
+		updated_at = now()
+		WHERE id = $5
+		AND deleted_at IS NULL
+	`
+	result, err := r.Pool.Exec(ctx, q,
+		profile.ProfileName,
+		profile.VATPercent,
+		profile.ServiceChargePercent,
+		profile.PriceIncludesTax,
+		id,
+	)
+	if isUniqueConstraintError(err) {
+		return errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes tax profile and detaches it from its categories and groups.
+func (r *TaxProfileRepo) Delete(ctx context.Context, id int) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	defer func() {
+		if tx.Conn() != nil {
+			tx.Conn().Close(ctx)
+		}
+	}()
+
+	q := `UPDATE tbl_tax_profiles
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	result, err := tx.Exec(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		err = errs.ErrNotFound
+		return err
+	}
+
+	q = `UPDATE tbl_categories SET tax_profile_id = NULL, updated_at = now() WHERE tax_profile_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+	q = `UPDATE tbl_groups SET tax_profile_id = NULL, updated_at = now() WHERE tax_profile_id = $1`
+	if _, err = tx.Exec(ctx, q, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lmnq/test-thai/database/postgres"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+type TranslationRepo struct {
+	*postgres.Postgres
+}
+
+func NewTranslationRepo(pg *postgres.Postgres) *TranslationRepo {
+	return &TranslationRepo{pg}
+}
+
+// translationTable is the table of the translations of an entity and its column of the entity id.
+type translationTable struct {
+	table    string
+	idColumn string
+}
+
+var _translationTables = map[string]translationTable{
+	model.TranslationItem:     {"tbl_item_translations", "item_id"},
+	model.TranslationCategory: {"tbl_category_translations", "category_id"},
+	model.TranslationGroup:    {"tbl_group_translations", "group_id"},
+}
+
+// translationMatch builds condition matching any translation of the entity with id
+// by the condition on the translated name column.
+func translationMatch(entity, id string, match func(column string) string) string {
+	t := _translationTables[entity]
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s AS tr WHERE tr.%s = %s AND %s)", t.table, t.idColumn, id, match("tr.name"))
+}
+
+// translationRank builds the best rank of the translations of the entity with id, null if there are none.
+func translationRank(entity, id string, rank func(column string) string) string {
+	t := _translationTables[entity]
+	return fmt.Sprintf("(SELECT max(%s) FROM %s AS tr WHERE tr.%s = %s)", rank("tr.name"), t.table, t.idColumn, id)
+}
+
+// translationName builds the name of the entity with id in the locale, null if there is no translation.
+func translationName(entity, id, locale string) string {
+	t := _translationTables[entity]
+	return fmt.Sprintf("(SELECT tr.name FROM %s AS tr WHERE tr.%s = %s AND tr.locale = %s)", t.table, t.idColumn, id, locale)
+}
+
+// Set creates or replaces the translation of the entity name.
+func (r *TranslationRepo) Set(ctx context.Context, entity string, id int, translation *model.Translation) error {
+	t := _translationTables[entity]
+	q := fmt.Sprintf(`INSERT INTO %s (%s, locale, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (%s, locale) DO UPDATE
+		SET name = EXCLUDED.name,
+		updated_at = now()
+	`, t.table, t.idColumn, t.idColumn)
+	_, err := r.Pool.Exec(ctx, q, id, translation.Locale, translation.Name)
+
+	return err
+}
+
+// GetAll returns the translations of the entity name ordered by locale.
+func (r *TranslationRepo) GetAll(ctx context.Context, entity string, id int) ([]*model.Translation, error) {
+	t := _translationTables[entity]
+	q := fmt.Sprintf(`SELECT
+			locale,
+			name,
+			created_at,
+			updated_at
+		FROM %s
+		WHERE %s = $1
+		ORDER BY locale
+	`, t.table, t.idColumn)
+	rows, err := r.Pool.Query(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := []*model.Translation{}
+	for rows.Next() {
+		var translation model.Translation
+		err := rows.Scan(
+			&translation.Locale,
+			&translation.Name,
+			&translation.CreatedAt,
+			&translation.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, &translation)
+	}
+
+	return translations, rows.Err()
+}
+
+// Names returns the names of the entities in the locale by id, entities without translation are missing.
+func (r *TranslationRepo) Names(ctx context.Context, entity, locale string, ids []int) (map[int]string, error) {
+	names := make(map[int]string)
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	t := _translationTables[entity]
+	q := fmt.Sprintf(`SELECT %s, name FROM %s WHERE locale = $1 AND %s = ANY($2)`, t.idColumn, t.table, t.idColumn)
+	rows, err := r.Pool.Query(ctx, q, locale, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id   int
+			name string
+		)
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+
+		names[id] = name
+	}
+
+	return names, rows.Err()
+}
+
+func (r *TranslationRepo) Delete(ctx context.Context, entity string, id int, locale string) error {
+	t := _translationTables[entity]
+	q := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND locale = $2`, t.table, t.idColumn)
+	result, err := r.Pool.Exec(ctx, q, id, locale)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -1,7 +1,12 @@
 package repo
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lmnq/test-thai/database/postgres"
 	"github.com/lmnq/test-thai/internal/errs"
 )
 
@@ -12,3 +17,43 @@ func isUniqueConstraintError(err error) bool {
 	}
 	return pgErr.Code == errs.UniqueConstraintCode
 }
+
+// uniqueConstraintName returns name of the violated unique index, empty if err is not a unique constraint error.
+func uniqueConstraintName(err error) string {
+	if !isUniqueConstraintError(err) {
+		return ""
+	}
+	return err.(*pgconn.PgError).ConstraintName
+}
+
+// getOrCreateIDByName returns id of the not deleted row of the table with the name,
+// creating the row if it does not exist.
+func getOrCreateIDByName(ctx context.Context, conn postgres.Connection, table, column, name string) (id int, created bool, err error) {
+	q := fmt.Sprintf(`SELECT id FROM %s WHERE %s = $1 AND deleted_at IS NULL`, table, column)
+	err = conn.QueryRow(ctx, q, name).Scan(&id)
+	if err == nil {
+		return id, false, nil
+	}
+	if err != pgx.ErrNoRows {
+		return 0, false, err
+	}
+
+	q = fmt.Sprintf(`INSERT INTO %s (%s) VALUES ($1) RETURNING id`, table, column)
+	err = conn.QueryRow(ctx, q, name).Scan(&id)
+	if isUniqueConstraintError(err) {
+		return 0, false, errs.ErrUniqueConstraint
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+// nullIfEmpty returns nil for empty s, so that empty value of the nullable column is stored as null.
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
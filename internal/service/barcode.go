@@ -0,0 +1,66 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+)
+
+// sku is up to 64 characters without spaces, e.g. TEA-GRN-500
+var _skuRegexp = regexp.MustCompile(`^\S{1,64}$`)
+
+// normalizeCode trims the sku or barcode, empty code is no code.
+func normalizeCode(code *string) *string {
+	if code == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*code)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
+
+func validSKU(sku string) bool {
+	return _skuRegexp.MatchString(sku)
+}
+
+// validBarcode checks that barcode is EAN-13 or UPC-A with valid check digit.
+// Digits are weighted 1 and 3 alternately from the check digit on the right,
+// so UPC-A is checked the same way as EAN-13 with leading zero.
+func validBarcode(barcode string) bool {
+	if len(barcode) != 12 && len(barcode) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(barcode); i++ {
+		if barcode[i] < '0' || barcode[i] > '9' {
+			return false
+		}
+		digit := int(barcode[i] - '0')
+		if (len(barcode)-1-i)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+const (
+	_invalidSKUMessage     = "invalid sku, expected up to 64 characters without spaces"
+	_invalidBarcodeMessage = "invalid barcode, expected EAN-13 or UPC-A with valid check digit"
+)
+
+// codeExistsError is the error of the sku or barcode taken by another item detail.
+func codeExistsError(op string, err error) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("%s error: %w", op, err),
+		Code:    400,
+		Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, err),
+	}
+}
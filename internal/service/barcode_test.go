@@ -0,0 +1,28 @@
+package service
+
+import "testing"
+
+func TestValidBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		want    bool
+	}{
+		{"EAN-13", "4006381333931", true},
+		{"UPC-A", "036000291452", true},
+		{"UPC-A with leading zero", "0036000291452", true},
+		{"wrong check digit", "4006381333932", false},
+		{"non-digits", "40063813339a1", false},
+		{"11 digits", "03600029145", false},
+		{"14 digits", "40063813339310", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validBarcode(tt.barcode); got != tt.want {
+				t.Errorf("validBarcode(%q) = %v, want %v", tt.barcode, got, tt.want)
+			}
+		})
+	}
+}
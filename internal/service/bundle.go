@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+type BundleService struct {
+	repo             repo.Bundle
+	itemDetailRepo   repo.ItemDetail
+	exchangeRateRepo repo.ExchangeRate
+	currency         CurrencyOptions
+}
+
+func NewBundleService(repo repo.Bundle, itemDetailRepo repo.ItemDetail, exchangeRateRepo repo.ExchangeRate, currency CurrencyOptions) *BundleService {
+	return &BundleService{
+		repo:             repo,
+		itemDetailRepo:   itemDetailRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		currency:         currency,
+	}
+}
+
+// validateBundle checks bundle fields and components of create and update.
+func validateBundle(bundle *model.Bundle) errs.Error {
+	errMsg := ""
+	switch {
+	case bundle.BundleName == "":
+		errMsg = "bundle name is empty"
+	case !validCurrency(bundle.Currency):
+		errMsg = "invalid currency, expected 3-letter code"
+	case bundle.ManualPrice != nil && *bundle.ManualPrice <= 0:
+		errMsg = "manual price must be greater than 0"
+	case len(bundle.Components) == 0:
+		errMsg = "components are empty"
+	}
+	if errMsg == "" {
+		errMsg = validateBundleComponents(bundle.Components)
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	return errs.NilError()
+}
+
+// validateBundleComponents returns error message of the first invalid component, empty if all are valid.
+// Each item detail is a component once, its quantity is the number of units.
+func validateBundleComponents(components []*model.BundleComponent) string {
+	seen := make(map[int]bool, len(components))
+	for _, component := range components {
+		switch {
+		case component.ItemDetailID <= 0:
+			return "invalid component item detail id"
+		case component.Quantity <= 0:
+			return "component quantity must be greater than 0"
+		case seen[component.ItemDetailID]:
+			return fmt.Sprintf("duplicate component item detail %d", component.ItemDetailID)
+		}
+		seen[component.ItemDetailID] = true
+	}
+
+	return ""
+}
+
+// checkComponents checks that the component item details exist
+// and their amounts can be converted to the bundle currency.
+func (s *BundleService) checkComponents(ctx context.Context, bundle *model.Bundle) errs.Error {
+	for _, component := range bundle.Components {
+		itemDetail, err := s.itemDetailRepo.Get(ctx, component.ItemDetailID)
+		if err == errs.ErrNotFound {
+			return errs.Error{
+				Err:     fmt.Errorf("item detail %d does not exist", component.ItemDetailID),
+				Code:    400,
+				Message: fmt.Sprintf("%s: item detail %d does not exist", errs.StatusBadRequestMessage, component.ItemDetailID),
+			}
+		}
+		if err != nil {
+			return errs.Error{
+				Err:     fmt.Errorf("get bundle component item detail error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+
+		_, err = exchangeRate(ctx, s.exchangeRateRepo, itemDetail.Currency, bundle.Currency)
+		if err == errs.ErrNotFound {
+			return noExchangeRateError(itemDetail.Currency, bundle.Currency)
+		}
+		if err != nil {
+			return errs.Error{
+				Err:     fmt.Errorf("get bundle exchange rate error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+	}
+
+	return errs.NilError()
+}
+
+// rollUp sets cost and computed price of the bundle from its components converted to the bundle currency,
+// the price is the manual price if it is set. Deleted components are still counted.
+func (s *BundleService) rollUp(ctx context.Context, bundle *model.Bundle) errs.Error {
+	// components are usually in one currency, each rate is looked up once
+	rates := make(map[string]decimal.Decimal)
+	cost, price := decimal.Zero, decimal.Zero
+	for _, component := range bundle.Components {
+		rate, ok := rates[component.Currency]
+		if !ok {
+			var err error
+			rate, err = exchangeRate(ctx, s.exchangeRateRepo, component.Currency, bundle.Currency)
+			if err == errs.ErrNotFound {
+				return noExchangeRateError(component.Currency, bundle.Currency)
+			}
+			if err != nil {
+				return errs.Error{
+					Err:     fmt.Errorf("get bundle exchange rate error: %w", err),
+					Code:    500,
+					Message: errs.StatusInternalServerErrorMessage,
+				}
+			}
+			rates[component.Currency] = rate
+		}
+
+		qty := decimal.NewFromInt(int64(component.Quantity))
+		cost = cost.Add(decimal.NewFromFloat(component.Cost).Mul(rate).Mul(qty))
+		price = price.Add(decimal.NewFromFloat(component.Price).Mul(rate).Mul(qty))
+	}
+
+	bundle.Cost = round(cost, s.currency.Rounding).InexactFloat64()
+	bundle.ComputedPrice = round(price, s.currency.Rounding).InexactFloat64()
+	bundle.Price = bundle.ComputedPrice
+	if bundle.ManualPrice != nil {
+		bundle.Price = *bundle.ManualPrice
+	}
+
+	return errs.NilError()
+}
+
+func (s *BundleService) Create(ctx context.Context, bundle *model.Bundle) (int, errs.Error) {
+	bundle.Currency = normalizeCurrency(bundle.Currency, s.currency.Default)
+	if myerr := validateBundle(bundle); myerr.IsErr() {
+		return 0, myerr
+	}
+	if myerr := s.checkComponents(ctx, bundle); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, bundle)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create bundle error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: bundle name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create bundle error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *BundleService) Get(ctx context.Context, id int) (*model.Bundle, errs.Error) {
+	bundle, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get bundle error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get bundle error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	if myerr := s.rollUp(ctx, bundle); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	return bundle, errs.NilError()
+}
+
+func (s *BundleService) GetAll(ctx context.Context, filter *model.BundleFilter, page *model.Page) ([]*model.Bundle, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	bundles, pageInfo, err := s.repo.GetAll(ctx, filter, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all bundles error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all bundles error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	for _, bundle := range bundles {
+		if myerr := s.rollUp(ctx, bundle); myerr.IsErr() {
+			return nil, nil, myerr
+		}
+	}
+
+	return bundles, pageInfo, errs.NilError()
+}
+
+func (s *BundleService) Update(ctx context.Context, id int, bundle *model.Bundle) errs.Error {
+	bundle.Currency = normalizeCurrency(bundle.Currency, s.currency.Default)
+	if myerr := validateBundle(bundle); myerr.IsErr() {
+		return myerr
+	}
+	if myerr := s.checkComponents(ctx, bundle); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, bundle)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update bundle error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: bundle name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update bundle error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update bundle error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *BundleService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete bundle error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete bundle error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
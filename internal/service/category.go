@@ -11,11 +11,15 @@ import (
 )
 
 type CategoryService struct {
-	repo repo.Category
+	repo           repo.Category
+	taxProfileRepo repo.TaxProfile
 }
 
-func NewCategoryService(repo repo.Category) *CategoryService {
-	return &CategoryService{repo}
+func NewCategoryService(repo repo.Category, taxProfileRepo repo.TaxProfile) *CategoryService {
+	return &CategoryService{
+		repo:           repo,
+		taxProfileRepo: taxProfileRepo,
+	}
 }
 
 func (s *CategoryService) Create(ctx context.Context, name string) (int, errs.Error) {
@@ -66,24 +70,65 @@ func (s *CategoryService) Get(ctx context.Context, id int) (*model.Category, err
 	return category, errs.NilError()
 }
 
-func (s *CategoryService) GetAll(ctx context.Context) ([]*model.Category, errs.Error) {
-	categories, err := s.repo.GetAll(ctx)
-	if err == errs.ErrNotFound {
-		return nil, errs.Error{
+func (s *CategoryService) GetAll(ctx context.Context, page *model.Page) ([]*model.Category, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	categories, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all categories error: %w", err),
-			Code:    404,
-			Message: errs.StatusNotFoundMessage,
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
 		}
 	}
 	if err != nil {
-		return nil, errs.Error{
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all categories error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
 
-	return categories, errs.NilError()
+	return categories, pageInfo, errs.NilError()
+}
+
+// GetTree returns the top level categories with their sub-categories, siblings are ordered by name.
+func (s *CategoryService) GetTree(ctx context.Context) ([]*model.CategoryNode, errs.Error) {
+	categories, err := s.repo.GetTree(ctx)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get category tree error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return categoryTree(categories), errs.NilError()
+}
+
+// categoryTree builds the tree of the categories in their order.
+// Categories without parent among them are top level.
+func categoryTree(categories []*model.Category) []*model.CategoryNode {
+	nodes := make(map[int]*model.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &model.CategoryNode{Category: category, Children: []*model.CategoryNode{}}
+	}
+
+	roots := []*model.CategoryNode{}
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if category.ParentID != nil {
+			if parent, ok := nodes[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+
+	return roots
 }
 
 func (s *CategoryService) Update(ctx context.Context, id int, name string) errs.Error {
@@ -121,6 +166,16 @@ func (s *CategoryService) Update(ctx context.Context, id int, name string) errs.
 	return errs.NilError()
 }
 
+// Patch updates category name if it is set, otherwise only checks that the category exists.
+func (s *CategoryService) Patch(ctx context.Context, id int, name *string) errs.Error {
+	if name == nil {
+		_, myerr := s.Get(ctx, id)
+		return myerr
+	}
+
+	return s.Update(ctx, id, *name)
+}
+
 func (s *CategoryService) Delete(ctx context.Context, id int) errs.Error {
 	err := s.repo.Delete(ctx, id)
 	if err == errs.ErrNotFound {
@@ -140,3 +195,74 @@ func (s *CategoryService) Delete(ctx context.Context, id int) errs.Error {
 
 	return errs.NilError()
 }
+
+// SetTaxProfile attaches tax profile to the category, nil profile detaches it.
+func (s *CategoryService) SetTaxProfile(ctx context.Context, id int, taxProfileID *int) errs.Error {
+	if myerr := checkTaxProfile(ctx, s.taxProfileRepo, taxProfileID); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.SetTaxProfile(ctx, id, taxProfileID)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("set category tax profile error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("set category tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// SetParent moves the category under the parent, nil parent makes it top level.
+func (s *CategoryService) SetParent(ctx context.Context, id int, parentID *int) errs.Error {
+	if parentID != nil {
+		exists, err := s.repo.Exists(ctx, *parentID)
+		if err != nil {
+			return errs.Error{
+				Err:     fmt.Errorf("check if parent category exists error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+		if !exists {
+			return errs.Error{
+				Err:     fmt.Errorf("parent category does not exist"),
+				Code:    400,
+				Message: fmt.Sprintf("%s: parent category does not exist", errs.StatusBadRequestMessage),
+			}
+		}
+	}
+
+	err := s.repo.SetParent(ctx, id, parentID)
+	if err == errs.ErrCategoryCycle {
+		return errs.Error{
+			Err:     fmt.Errorf("set category parent error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: parent category is the category or its sub-category", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("set category parent error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("set category parent error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+// CurrencyOptions are the default currency of item details
+// and the rounding of prices converted to other currencies.
+type CurrencyOptions struct {
+	Default  string
+	Rounding model.Rounding
+}
+
+// Validate checks the default currency and rounding mode.
+func (o CurrencyOptions) Validate() error {
+	if !validCurrency(o.Default) {
+		return fmt.Errorf("invalid default currency %q", o.Default)
+	}
+	if !slices.Contains(model.RoundingModes, o.Rounding.Mode) {
+		return fmt.Errorf("invalid rounding mode %q", o.Rounding.Mode)
+	}
+
+	return nil
+}
+
+var _currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// normalizeCurrency uppercases currency code, empty code is the default currency.
+func normalizeCurrency(currency, defaultCurrency string) string {
+	if currency == "" {
+		return defaultCurrency
+	}
+
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// validCurrency checks that currency is ISO 4217 alphabetic code.
+func validCurrency(currency string) bool {
+	return _currencyRegexp.MatchString(currency)
+}
+
+func invalidCurrencyError(currency string) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("invalid currency %q", currency),
+		Code:    400,
+		Message: fmt.Sprintf("%s: invalid currency %s, expected 3-letter code", errs.StatusBadRequestMessage, currency),
+	}
+}
+
+// round rounds d to the decimals by the rounding mode.
+func round(d decimal.Decimal, rounding model.Rounding) decimal.Decimal {
+	places := int32(rounding.Decimals)
+	switch rounding.Mode {
+	case model.RoundingHalfEven:
+		return d.RoundBank(places)
+	case model.RoundingCeil:
+		return d.RoundCeil(places)
+	case model.RoundingFloor:
+		return d.RoundFloor(places)
+	default:
+		return d.Round(places)
+	}
+}
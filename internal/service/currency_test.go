@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		halfUp   string
+		halfEven string
+		ceil     string
+		floor    string
+	}{
+		{"1.2", 2, "1.2", "1.2", "1.2", "1.2"},
+		{"1.0049", 2, "1", "1", "1.01", "1"},
+		{"1.005", 2, "1.01", "1", "1.01", "1"},
+		{"1.015", 2, "1.02", "1.02", "1.02", "1.01"},
+		{"1.0051", 2, "1.01", "1.01", "1.01", "1"},
+		{"-1.005", 2, "-1.01", "-1", "-1", "-1.01"},
+		{"2.5", 0, "3", "2", "3", "2"},
+		{"3.5", 0, "4", "4", "4", "3"},
+		{"125", -1, "130", "120", "130", "120"},
+	}
+
+	for _, tt := range tests {
+		value := decimal.RequireFromString(tt.value)
+		for mode, want := range map[string]string{
+			model.RoundingHalfUp:   tt.halfUp,
+			model.RoundingHalfEven: tt.halfEven,
+			model.RoundingCeil:     tt.ceil,
+			model.RoundingFloor:    tt.floor,
+		} {
+			got := round(value, model.Rounding{Mode: mode, Decimals: tt.decimals})
+			if !got.Equal(decimal.RequireFromString(want)) {
+				t.Errorf("round(%s, %s, %d) = %s, want %s", tt.value, mode, tt.decimals, got, want)
+			}
+		}
+	}
+}
+
+// exchangeRateRepo is the exchange rate repo with the rates of the currency pairs.
+type exchangeRateRepo struct {
+	repo.ExchangeRate
+	rates map[[2]string]float64
+}
+
+func (r exchangeRateRepo) GetByCurrencies(ctx context.Context, base, quote string) (*model.ExchangeRate, error) {
+	rate, ok := r.rates[[2]string{base, quote}]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+
+	return &model.ExchangeRate{BaseCurrency: base, QuoteCurrency: quote, Rate: rate}, nil
+}
+
+var _testExchangeRates = exchangeRateRepo{rates: map[[2]string]float64{{"USD", "THB"}: 36.5}}
+
+func TestExchangeRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+		err      error
+	}{
+		{"same currency", "THB", "THB", "1", nil},
+		{"direct", "USD", "THB", "36.5", nil},
+		{"inverse", "THB", "USD", "0.0273972602739726", nil},
+		{"no rate", "EUR", "THB", "0", errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exchangeRate(context.Background(), _testExchangeRates, tt.from, tt.to)
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("rate = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertItemDetails(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name        string
+		from, to    string
+		cost, price string
+		mode        string
+		wantCost    string
+		wantPrice   string
+		wantMargin  string
+	}{
+		// 2.01 * 36.5 = 73.365 and 10.05 * 36.5 = 366.825 are on the half
+		{"direct half_up", "USD", "THB", "2.01", "10.05", model.RoundingHalfUp, "73.37", "366.83", "293.46"},
+		{"direct half_even", "USD", "THB", "2.01", "10.05", model.RoundingHalfEven, "73.36", "366.82", "293.46"},
+		{"direct ceil", "USD", "THB", "2.01", "10.05", model.RoundingCeil, "73.37", "366.83", "293.46"},
+		{"direct floor", "USD", "THB", "2.01", "10.05", model.RoundingFloor, "73.36", "366.82", "293.46"},
+
+		// 100 / 36.5 = 2.7397..., 50 / 36.5 = 1.3698...
+		{"inverse half_up", "THB", "USD", "50", "100", model.RoundingHalfUp, "1.37", "2.74", "1.37"},
+		{"inverse floor", "THB", "USD", "50", "100", model.RoundingFloor, "1.36", "2.73", "1.37"},
+		{"inverse ceil", "THB", "USD", "50", "100", model.RoundingCeil, "1.37", "2.74", "1.37"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewExchangeRateService(_testExchangeRates, model.Rounding{Mode: tt.mode, Decimals: 2})
+			itemDetail := &model.ItemDetailView{Currency: tt.from, Cost: d(tt.cost), Price: d(tt.price)}
+
+			if err := s.ConvertItemDetails(context.Background(), tt.to, itemDetail); err.Err != nil {
+				t.Fatalf("convert: %v", err.Err)
+			}
+
+			if !itemDetail.Cost.Equal(d(tt.wantCost)) || !itemDetail.Price.Equal(d(tt.wantPrice)) ||
+				!itemDetail.Margin.Equal(d(tt.wantMargin)) {
+				t.Errorf("cost, price, margin = %s, %s, %s, want %s, %s, %s", itemDetail.Cost, itemDetail.Price,
+					itemDetail.Margin, tt.wantCost, tt.wantPrice, tt.wantMargin)
+			}
+			if itemDetail.Currency != tt.to {
+				t.Errorf("currency = %s, want %s", itemDetail.Currency, tt.to)
+			}
+			if !itemDetail.GrossPrice.Equal(itemDetail.Price) {
+				t.Errorf("gross price = %s, want the converted price %s without tax profile", itemDetail.GrossPrice, itemDetail.Price)
+			}
+		})
+	}
+}
+
+func TestConvertItemDetailsNoRate(t *testing.T) {
+	s := NewExchangeRateService(_testExchangeRates, model.Rounding{Mode: model.RoundingHalfUp, Decimals: 2})
+	itemDetail := &model.ItemDetailView{Currency: "EUR", Price: decimal.NewFromInt(10)}
+
+	if err := s.ConvertItemDetails(context.Background(), "THB", itemDetail); err.Code != 400 {
+		t.Errorf("code = %d, want 400 for the missing exchange rate", err.Code)
+	}
+	if !itemDetail.Price.Equal(decimal.NewFromInt(10)) || itemDetail.Currency != "EUR" {
+		t.Errorf("item detail was converted without an exchange rate: %s %s", itemDetail.Price, itemDetail.Currency)
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+type ExchangeRateService struct {
+	repo     repo.ExchangeRate
+	rounding model.Rounding
+}
+
+func NewExchangeRateService(repo repo.ExchangeRate, rounding model.Rounding) *ExchangeRateService {
+	return &ExchangeRateService{
+		repo:     repo,
+		rounding: rounding,
+	}
+}
+
+// validateExchangeRate normalizes currencies of the rate and checks its fields.
+func validateExchangeRate(rate *model.ExchangeRate) errs.Error {
+	rate.BaseCurrency = normalizeCurrency(rate.BaseCurrency, "")
+	rate.QuoteCurrency = normalizeCurrency(rate.QuoteCurrency, "")
+
+	errMsg := ""
+	switch {
+	case !validCurrency(rate.BaseCurrency):
+		errMsg = "invalid base currency, expected 3-letter code"
+	case !validCurrency(rate.QuoteCurrency):
+		errMsg = "invalid quote currency, expected 3-letter code"
+	case rate.BaseCurrency == rate.QuoteCurrency:
+		errMsg = "base and quote currencies are the same"
+	case rate.Rate <= 0:
+		errMsg = "rate must be greater than 0"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ExchangeRateService) Create(ctx context.Context, rate *model.ExchangeRate) (int, errs.Error) {
+	if myerr := validateExchangeRate(rate); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, rate)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create exchange rate error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: exchange rate of the currencies already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create exchange rate error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *ExchangeRateService) Get(ctx context.Context, id int) (*model.ExchangeRate, errs.Error) {
+	rate, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get exchange rate error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get exchange rate error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return rate, errs.NilError()
+}
+
+func (s *ExchangeRateService) GetAll(ctx context.Context, page *model.Page) ([]*model.ExchangeRate, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	rates, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all exchange rates error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all exchange rates error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return rates, pageInfo, errs.NilError()
+}
+
+func (s *ExchangeRateService) Update(ctx context.Context, id int, rate *model.ExchangeRate) errs.Error {
+	if myerr := validateExchangeRate(rate); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, rate)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update exchange rate error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: exchange rate of the currencies already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update exchange rate error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update exchange rate error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ExchangeRateService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete exchange rate error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete exchange rate error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// exchangeRate returns the rate from one currency to the other,
+// the inverse of the other pair is used if the pair has no rate.
+func exchangeRate(ctx context.Context, rateRepo repo.ExchangeRate, from, to string) (decimal.Decimal, error) {
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := rateRepo.GetByCurrencies(ctx, from, to)
+	if err == nil {
+		return decimal.NewFromFloat(rate.Rate), nil
+	}
+	if err != errs.ErrNotFound {
+		return decimal.Decimal{}, err
+	}
+
+	rate, err = rateRepo.GetByCurrencies(ctx, to, from)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	// keep more places than the stored rate, the result is rounded after conversion
+	return decimal.NewFromInt(1).DivRound(decimal.NewFromFloat(rate.Rate), 16), nil
+}
+
+// noExchangeRateError is the error of the amounts that can not be converted to the currency.
+func noExchangeRateError(from, to string) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("no exchange rate %s/%s", from, to),
+		Code:    400,
+		Message: fmt.Sprintf("%s: no exchange rate from %s to %s", errs.StatusBadRequestMessage, from, to),
+	}
+}
+
+// ConvertItemDetails converts cost, price, margin and tax amounts of the item details to the currency.
+// Converted values are rounded by the configured rounding, margin percents do not change,
+// tax amounts are calculated again from the converted price.
+func (s *ExchangeRateService) ConvertItemDetails(ctx context.Context, currency string, itemDetails ...*model.ItemDetailView) errs.Error {
+	currency = normalizeCurrency(currency, "")
+	if !validCurrency(currency) {
+		return invalidCurrencyError(currency)
+	}
+
+	// item details are usually in a few currencies, each rate is looked up once
+	rates := make(map[string]decimal.Decimal)
+	for _, itemDetail := range itemDetails {
+		if itemDetail.Currency == currency {
+			continue
+		}
+
+		rate, ok := rates[itemDetail.Currency]
+		if !ok {
+			var err error
+			rate, err = exchangeRate(ctx, s.repo, itemDetail.Currency, currency)
+			if err == errs.ErrNotFound {
+				return noExchangeRateError(itemDetail.Currency, currency)
+			}
+			if err != nil {
+				return errs.Error{
+					Err:     fmt.Errorf("convert item details error: %w", err),
+					Code:    500,
+					Message: errs.StatusInternalServerErrorMessage,
+				}
+			}
+			rates[itemDetail.Currency] = rate
+		}
+
+		itemDetail.Cost = round(itemDetail.Cost.Mul(rate), s.rounding)
+		itemDetail.Price = round(itemDetail.Price.Mul(rate), s.rounding)
+		itemDetail.Margin = itemDetail.Price.Sub(itemDetail.Cost)
+		itemDetail.Currency = currency
+		for _, group := range itemDetail.ModifierGroups {
+			for _, modifier := range group.Modifiers {
+				modifier.PriceDelta = round(decimal.NewFromFloat(modifier.PriceDelta).Mul(rate), s.rounding).InexactFloat64()
+			}
+		}
+		applyTax(itemDetail, s.rounding)
+	}
+
+	return errs.NilError()
+}
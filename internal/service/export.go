@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+)
+
+// ExportOptions limit the exports running at once, each one holds a database connection
+// until its response is written, so it should be less than the connection pool size.
+type ExportOptions struct {
+	MaxConcurrent int
+}
+
+// Validate checks the export limit.
+func (o ExportOptions) Validate() error {
+	if o.MaxConcurrent < 1 {
+		return fmt.Errorf("invalid max concurrent exports %d, expected at least 1", o.MaxConcurrent)
+	}
+
+	return nil
+}
+
+type ExportService struct {
+	itemDetailRepo repo.ItemDetail
+	rounding       model.Rounding
+	locale         LocaleOptions
+	slots          chan struct{} // taken by the running exports
+}
+
+func NewExportService(itemDetailRepo repo.ItemDetail, rounding model.Rounding, locale LocaleOptions, options ExportOptions) *ExportService {
+	return &ExportService{
+		itemDetailRepo: itemDetailRepo,
+		rounding:       rounding,
+		locale:         locale,
+		slots:          make(chan struct{}, options.MaxConcurrent),
+	}
+}
+
+// ItemDetails validates the filter and returns function streaming the item detail list by filter to fn,
+// with the names in the locale. The list is validated before the response starts, so its errors can still
+// be reported, and streamed later while the response body is written.
+// The stream takes a slot of the running exports until it ends, so it must be called once.
+func (s *ExportService) ItemDetails(ctx context.Context, filter *model.ItemDetailFilter, locale string) (func(fn func(*model.ItemDetailView) error) error, errs.Error) {
+	if myerr := validateItemDetailFilter(filter); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		return nil, errs.Error{
+			Err:     errors.New("too many exports in progress"),
+			Code:    429,
+			Message: "too many exports in progress, try again later",
+		}
+	}
+
+	// names in the default locale are not translated
+	if locale == s.locale.Default {
+		locale = ""
+	}
+
+	return func(fn func(*model.ItemDetailView) error) error {
+		defer func() { <-s.slots }()
+
+		return s.itemDetailRepo.Export(ctx, filter, locale, func(itemDetail *model.ItemDetailView) error {
+			applyTax(itemDetail, s.rounding)
+			return fn(itemDetail)
+		})
+	}, errs.NilError()
+}
@@ -11,11 +11,15 @@ import (
 )
 
 type GroupService struct {
-	repo repo.Group
+	repo           repo.Group
+	taxProfileRepo repo.TaxProfile
 }
 
-func NewGroupService(repo repo.Group) *GroupService {
-	return &GroupService{repo}
+func NewGroupService(repo repo.Group, taxProfileRepo repo.TaxProfile) *GroupService {
+	return &GroupService{
+		repo:           repo,
+		taxProfileRepo: taxProfileRepo,
+	}
 }
 
 func (s *GroupService) Create(ctx context.Context, name string) (int, errs.Error) {
@@ -66,24 +70,28 @@ func (s *GroupService) Get(ctx context.Context, id int) (*model.Group, errs.Erro
 	return group, errs.NilError()
 }
 
-func (s *GroupService) GetAll(ctx context.Context) ([]*model.Group, errs.Error) {
-	groups, err := s.repo.GetAll(ctx)
-	if err == errs.ErrNotFound {
-		return nil, errs.Error{
+func (s *GroupService) GetAll(ctx context.Context, page *model.Page) ([]*model.Group, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	groups, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all groups error: %w", err),
-			Code:    404,
-			Message: errs.StatusNotFoundMessage,
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
 		}
 	}
 	if err != nil {
-		return nil, errs.Error{
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all groups error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
 
-	return groups, errs.NilError()
+	return groups, pageInfo, errs.NilError()
 }
 
 func (s *GroupService) Update(ctx context.Context, id int, name string) errs.Error {
@@ -121,6 +129,16 @@ func (s *GroupService) Update(ctx context.Context, id int, name string) errs.Err
 	return errs.NilError()
 }
 
+// Patch updates group name if it is set, otherwise only checks that the group exists.
+func (s *GroupService) Patch(ctx context.Context, id int, name *string) errs.Error {
+	if name == nil {
+		_, myerr := s.Get(ctx, id)
+		return myerr
+	}
+
+	return s.Update(ctx, id, *name)
+}
+
 func (s *GroupService) Delete(ctx context.Context, id int) errs.Error {
 	err := s.repo.Delete(ctx, id)
 	if err == errs.ErrNotFound {
@@ -140,3 +158,28 @@ func (s *GroupService) Delete(ctx context.Context, id int) errs.Error {
 
 	return errs.NilError()
 }
+
+// SetTaxProfile attaches tax profile to the group, nil profile detaches it.
+func (s *GroupService) SetTaxProfile(ctx context.Context, id int, taxProfileID *int) errs.Error {
+	if myerr := checkTaxProfile(ctx, s.taxProfileRepo, taxProfileID); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.SetTaxProfile(ctx, id, taxProfileID)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("set group tax profile error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("set group tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
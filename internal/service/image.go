@@ -0,0 +1,291 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // decode png uploads
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/lmnq/test-thai/storage"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // decode webp uploads
+)
+
+// ImageOptions are the limits of the uploaded item images and the size of their thumbnails.
+type ImageOptions struct {
+	MaxSize       int64 // bytes
+	MaxPixels     int   // width * height, so that small files do not decode to huge images
+	ThumbnailSize int   // max width and height of the thumbnails
+}
+
+// Validate checks that the limits and the thumbnail size are positive.
+func (o ImageOptions) Validate() error {
+	if o.MaxSize <= 0 {
+		return fmt.Errorf("invalid max image size %d", o.MaxSize)
+	}
+	if o.MaxPixels <= 0 {
+		return fmt.Errorf("invalid max image pixels %d", o.MaxPixels)
+	}
+	if o.ThumbnailSize <= 0 {
+		return fmt.Errorf("invalid thumbnail size %d", o.ThumbnailSize)
+	}
+
+	return nil
+}
+
+// content types of the accepted images and the extensions of their storage keys
+var (
+	_imageContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+	_imageExtensions   = map[string]string{"image/jpeg": ".jpg", "image/png": ".png", "image/webp": ".webp"}
+)
+
+const _thumbnailQuality = 85
+
+type ImageService struct {
+	repo     repo.Image
+	itemRepo repo.Item
+	storage  storage.Storage
+	options  ImageOptions
+}
+
+func NewImageService(repo repo.Image, itemRepo repo.Item, storage storage.Storage, options ImageOptions) *ImageService {
+	return &ImageService{
+		repo:     repo,
+		itemRepo: itemRepo,
+		storage:  storage,
+		options:  options,
+	}
+}
+
+func invalidImageError(errMsg string) errs.Error {
+	return errs.Error{
+		Err:     errors.New(errMsg),
+		Code:    400,
+		Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+	}
+}
+
+// readImage reads the image file up to the max size and checks its content type by its content,
+// the content type declared by the client is not trusted.
+func (s *ImageService) readImage(file io.Reader) ([]byte, string, errs.Error) {
+	data, err := io.ReadAll(io.LimitReader(file, s.options.MaxSize+1))
+	if err != nil {
+		return nil, "", errs.Error{
+			Err:     fmt.Errorf("read image error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: read image error", errs.StatusBadRequestMessage),
+		}
+	}
+	if len(data) == 0 {
+		return nil, "", invalidImageError("image is empty")
+	}
+	if int64(len(data)) > s.options.MaxSize {
+		return nil, "", invalidImageError(fmt.Sprintf("image is larger than %d bytes", s.options.MaxSize))
+	}
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(_imageContentTypes, contentType) {
+		return nil, "", invalidImageError(fmt.Sprintf("unsupported image type %s, expected jpeg, png or webp", contentType))
+	}
+
+	return data, contentType, errs.NilError()
+}
+
+// thumbnail scales the image down to fit the thumbnail size and encodes it as jpeg,
+// transparent pixels become white. Smaller images are not scaled up.
+func (s *ImageService) thumbnail(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if size := s.options.ThumbnailSize; width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(thumbnail, thumbnail.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), img, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: _thumbnailQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Upload validates the image, stores it with its thumbnail and returns its id.
+func (s *ImageService) Upload(ctx context.Context, itemID int, file io.Reader) (int, errs.Error) {
+	if myerr := checkTargetExists(ctx, s.itemRepo.Exists, itemID, "item", 404); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	data, contentType, myerr := s.readImage(file)
+	if myerr.IsErr() {
+		return 0, myerr
+	}
+
+	// check the dimensions before decoding the whole image
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, invalidImageError("invalid image: " + err.Error())
+	}
+	if config.Width*config.Height > s.options.MaxPixels {
+		return 0, invalidImageError(fmt.Sprintf("image is larger than %d pixels", s.options.MaxPixels))
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, invalidImageError("invalid image: " + err.Error())
+	}
+
+	thumbnail, err := s.thumbnail(img)
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create thumbnail error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	name := fmt.Sprintf("items/%d/%s", itemID, uuid.NewString())
+	itemImage := &model.ItemImage{
+		ItemID:       itemID,
+		ContentType:  contentType,
+		Size:         int64(len(data)),
+		Width:        config.Width,
+		Height:       config.Height,
+		Key:          name + _imageExtensions[contentType],
+		ThumbnailKey: name + "_thumbnail.jpg",
+	}
+
+	if myerr := s.store(ctx, itemImage, data, thumbnail); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, itemImage)
+	if err != nil {
+		s.deleteBlobs(itemImage)
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create item image error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+// store puts the image and its thumbnail to the storage, the image is deleted if the thumbnail fails.
+func (s *ImageService) store(ctx context.Context, itemImage *model.ItemImage, data, thumbnail []byte) errs.Error {
+	err := s.storage.Put(ctx, itemImage.Key, bytes.NewReader(data), int64(len(data)), itemImage.ContentType)
+	if err == nil {
+		err = s.storage.Put(ctx, itemImage.ThumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), model.ThumbnailContentType)
+		if err != nil {
+			s.deleteBlobs(itemImage)
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("store item image error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// deleteBlobs deletes the image and its thumbnail from the storage.
+// It is best effort, a blob left by failed delete is not referenced anymore.
+func (s *ImageService) deleteBlobs(itemImage *model.ItemImage) {
+	ctx := context.Background()
+	s.storage.Delete(ctx, itemImage.Key)
+	s.storage.Delete(ctx, itemImage.ThumbnailKey)
+}
+
+func (s *ImageService) GetAll(ctx context.Context, itemID int) ([]*model.ItemImage, errs.Error) {
+	if myerr := checkTargetExists(ctx, s.itemRepo.Exists, itemID, "item", 404); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	images, err := s.repo.GetAll(ctx, itemID)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get all item images error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return images, errs.NilError()
+}
+
+// Open returns the image of the item with the reader of its content, or of its thumbnail content.
+// The reader must be closed.
+func (s *ImageService) Open(ctx context.Context, itemID, id int, thumbnail bool) (*model.ItemImage, io.ReadCloser, errs.Error) {
+	itemImage, err := s.repo.Get(ctx, itemID, id)
+	if err == errs.ErrNotFound {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get item image error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get item image error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	key := itemImage.Key
+	if thumbnail {
+		key = itemImage.ThumbnailKey
+	}
+	r, err := s.storage.Get(ctx, key)
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("open item image %s error: %w", key, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return itemImage, r, errs.NilError()
+}
+
+// Delete deletes the image of the item with its thumbnail.
+func (s *ImageService) Delete(ctx context.Context, itemID, id int) errs.Error {
+	itemImage, err := s.repo.Delete(ctx, itemID, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete item image error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete item image error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	s.deleteBlobs(itemImage)
+
+	return errs.NilError()
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+)
+
+const _maxImportRows = 5000
+
+// required item detail import csv columns,
+// optional currency column is the default currency if missing or empty
+var _itemDetailImportColumns = []string{"item_name", "category_name", "group_name", "cost", "price", "sort"}
+
+type ImportService struct {
+	itemDetailRepo  repo.ItemDetail
+	defaultCurrency string
+}
+
+func NewImportService(itemDetailRepo repo.ItemDetail, defaultCurrency string) *ImportService {
+	return &ImportService{
+		itemDetailRepo:  itemDetailRepo,
+		defaultCurrency: defaultCurrency,
+	}
+}
+
+// ItemDetails creates or updates item details from csv file with the header row.
+// Nothing is written if dry run or any row fails, the report shows what the import does anyway.
+func (s *ImportService) ItemDetails(ctx context.Context, file io.Reader, dryRun bool) (*model.ItemDetailImportReport, errs.Error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, importFileError(errors.New("file is empty"))
+	}
+	if err != nil {
+		return nil, importFileError(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// excel puts BOM before the first column name
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range _itemDetailImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, importFileError(fmt.Errorf("missing column %s", name))
+		}
+	}
+
+	// results are in the file order, the valid rows go to the repo
+	var (
+		results    []*model.ItemDetailImportResult
+		rows       []*model.ItemDetailImportRow
+		rowIndexes []int
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, importFileError(err)
+		}
+		if len(results) == _maxImportRows {
+			return nil, importFileError(fmt.Errorf("too many rows, max is %d", _maxImportRows))
+		}
+
+		line, _ := reader.FieldPos(0)
+		row, errMsg := parseItemDetailImportRecord(record, columns, line, s.defaultCurrency)
+		if errMsg != "" {
+			results = append(results, &model.ItemDetailImportResult{
+				Line:   line,
+				Action: model.ImportActionError,
+				Error:  fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+			})
+			continue
+		}
+
+		results = append(results, nil)
+		rows = append(rows, row)
+		rowIndexes = append(rowIndexes, len(results)-1)
+	}
+	if len(results) == 0 {
+		return nil, importFileError(errors.New("file has no rows"))
+	}
+
+	report := &model.ItemDetailImportReport{DryRun: dryRun}
+	if len(rows) > 0 {
+		commit := !dryRun && len(rows) == len(results)
+		repoResults, rowErrs, committed, err := s.itemDetailRepo.Import(ctx, rows, commit)
+		if err != nil {
+			return nil, errs.Error{
+				Err:     fmt.Errorf("import item details error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+
+		for j, i := range rowIndexes {
+			results[i] = repoResults[j]
+			if rowErrs[j] != nil {
+				results[i].Error = importRowError(rowErrs[j]).Message
+			}
+		}
+		report.Applied = committed
+	}
+
+	for _, result := range results {
+		switch result.Action {
+		case model.ImportActionCreate:
+			report.Created++
+		case model.ImportActionUpdate:
+			report.Updated++
+		case model.ImportActionUnchanged:
+			report.Unchanged++
+		case model.ImportActionError:
+			report.Failed++
+		}
+	}
+	report.Rows = results
+
+	return report, errs.NilError()
+}
+
+// parseItemDetailImportRecord parses and validates csv record,
+// errMsg is not empty if the record is invalid.
+func parseItemDetailImportRecord(record []string, columns map[string]int, line int, defaultCurrency string) (row *model.ItemDetailImportRow, errMsg string) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	row = &model.ItemDetailImportRow{
+		Line:         line,
+		ItemName:     field("item_name"),
+		CategoryName: field("category_name"),
+		GroupName:    field("group_name"),
+		Currency:     normalizeCurrency(field("currency"), defaultCurrency),
+	}
+
+	var err error
+	switch {
+	case row.ItemName == "":
+		return nil, "item name is empty"
+	case row.CategoryName == "":
+		return nil, "category name is empty"
+	case row.GroupName == "":
+		return nil, "group name is empty"
+	}
+	if row.Cost, err = strconv.ParseFloat(field("cost"), 64); err != nil {
+		return nil, "invalid cost"
+	}
+	if row.Price, err = strconv.ParseFloat(field("price"), 64); err != nil {
+		return nil, "invalid price"
+	}
+	if row.Sort, err = strconv.Atoi(field("sort")); err != nil {
+		return nil, "invalid sort"
+	}
+	switch {
+	case row.Cost <= 0:
+		return nil, "cost must be greater than 0"
+	case row.Price <= 0:
+		return nil, "price must be greater than 0"
+	case row.Sort <= 0:
+		return nil, "sort must be greater than 0"
+	case !validCurrency(row.Currency):
+		return nil, "invalid currency, expected 3-letter code"
+	}
+
+	return row, ""
+}
+
+func importFileError(err error) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("import file error: %w", err),
+		Code:    400,
+		Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, err),
+	}
+}
+
+// importRowError converts repo error of the failed row.
+func importRowError(err error) errs.Error {
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("import item detail row error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: category or group name is used by a deleted one", errs.StatusBadRequestMessage),
+		}
+	}
+
+	return errs.Error{
+		Err:     fmt.Errorf("import item detail row error: %w", err),
+		Code:    500,
+		Message: errs.StatusInternalServerErrorMessage,
+	}
+}
@@ -66,24 +66,32 @@ func (s *ItemService) Get(ctx context.Context, id int) (*model.Item, errs.Error)
 	return item, errs.NilError()
 }
 
-func (s *ItemService) GetAll(ctx context.Context) ([]*model.Item, errs.Error) {
-	items, err := s.repo.GetAll(ctx)
-	if errors.Is(err, errs.ErrNotFound) {
-		return nil, errs.Error{
+func (s *ItemService) GetAll(ctx context.Context, filter *model.ItemFilter, page *model.Page) ([]*model.Item, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	if myerr := validateTagsFilter(&filter.Tags, &filter.TagsMode); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	items, pageInfo, err := s.repo.GetAll(ctx, filter, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all items error: %w", err),
-			Code:    404,
-			Message: errs.StatusNotFoundMessage,
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
 		}
 	}
 	if err != nil {
-		return nil, errs.Error{
+		return nil, nil, errs.Error{
 			Err:     fmt.Errorf("get all items error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
 
-	return items, errs.NilError()
+	return items, pageInfo, errs.NilError()
 }
 
 func (s *ItemService) Update(ctx context.Context, id int, name string) errs.Error {
@@ -121,6 +129,16 @@ func (s *ItemService) Update(ctx context.Context, id int, name string) errs.Erro
 	return errs.NilError()
 }
 
+// Patch updates item name if it is set, otherwise only checks that the item exists.
+func (s *ItemService) Patch(ctx context.Context, id int, name *string) errs.Error {
+	if name == nil {
+		_, myerr := s.Get(ctx, id)
+		return myerr
+	}
+
+	return s.Update(ctx, id, *name)
+}
+
 func (s *ItemService) Delete(ctx context.Context, id int) errs.Error {
 	err := s.repo.Delete(ctx, id)
 	if err == errs.ErrNotFound {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/lmnq/test-thai/internal/errs"
 	"github.com/lmnq/test-thai/internal/model"
@@ -15,6 +18,10 @@ type ItemDetailService struct {
 	itemRepo     repo.Item
 	groupRepo    repo.Group
 	categoryRepo repo.Category
+
+	modifierGroupRepo repo.ModifierGroup
+
+	currency CurrencyOptions
 }
 
 func NewItemDetailService(
@@ -22,18 +29,43 @@ func NewItemDetailService(
 	itemRepo repo.Item,
 	groupRepo repo.Group,
 	categoryRepo repo.Category,
+	modifierGroupRepo repo.ModifierGroup,
+	currency CurrencyOptions,
 ) *ItemDetailService {
 	return &ItemDetailService{
-		repo:         repo,
-		itemRepo:     itemRepo,
-		groupRepo:    groupRepo,
-		categoryRepo: categoryRepo,
+		repo:              repo,
+		itemRepo:          itemRepo,
+		groupRepo:         groupRepo,
+		categoryRepo:      categoryRepo,
+		modifierGroupRepo: modifierGroupRepo,
+		currency:          currency,
 	}
 }
 
-func (s *ItemDetailService) Create(ctx context.Context,
-	itemDetail *model.ItemDetail, itemName string,
-) (int, errs.Error) {
+// normalizeVariantName trims the variant name, empty name is no variant.
+func normalizeVariantName(variantName *string) *string {
+	if variantName == nil {
+		return nil
+	}
+	name := strings.TrimSpace(*variantName)
+	if name == "" {
+		return nil
+	}
+
+	return &name
+}
+
+// variantExistsError is the error of the variant name taken by another item detail of the item.
+func variantExistsError(op string, err error) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("%s error: %w", op, err),
+		Code:    400,
+		Message: fmt.Sprintf("%s: variant name already exists for the item", errs.StatusBadRequestMessage),
+	}
+}
+
+// validateItemDetail checks item detail fields of create and update.
+func validateItemDetail(itemDetail *model.ItemDetail, itemName string) errs.Error {
 	errMsg := ""
 	switch {
 	case itemName == "":
@@ -48,50 +80,85 @@ func (s *ItemDetailService) Create(ctx context.Context,
 		errMsg = "price must be greater than 0"
 	case itemDetail.Sort <= 0:
 		errMsg = "sort must be greater than 0"
+	case !validCurrency(itemDetail.Currency):
+		errMsg = "invalid currency, expected 3-letter code"
+	case itemDetail.SKU != nil && !validSKU(*itemDetail.SKU):
+		errMsg = _invalidSKUMessage
+	case itemDetail.Barcode != nil && !validBarcode(*itemDetail.Barcode):
+		errMsg = _invalidBarcodeMessage
 	}
 	if errMsg != "" {
-		return 0, errs.Error{
+		return errs.Error{
 			Err:     errors.New(errMsg),
 			Code:    400,
 			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
 		}
 	}
 
-	groupIDExists, err := s.groupRepo.Exists(ctx, itemDetail.GroupID)
+	return errs.NilError()
+}
+
+// checkGroupAndCategory checks that group and category of the item detail exist.
+func (s *ItemDetailService) checkGroupAndCategory(ctx context.Context, groupID, categoryID int) errs.Error {
+	groupIDExists, err := s.groupRepo.Exists(ctx, groupID)
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if group exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
 	if !groupIDExists {
-		return 0, errs.Error{
+		return errs.Error{
 			Err:     fmt.Errorf("group does not exist"),
 			Code:    400,
 			Message: fmt.Sprintf("%s: group does not exist", errs.StatusBadRequestMessage),
 		}
 	}
+
+	categoryIDExists, err := s.categoryRepo.Exists(ctx, categoryID)
 	if err != nil {
-		return 0, errs.Error{
-			Err:     fmt.Errorf("check if group exists error: %w", err),
+		return errs.Error{
+			Err:     fmt.Errorf("check if category exists error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
-
-	categoryIDExists, err := s.categoryRepo.Exists(ctx, itemDetail.CategoryID)
 	if !categoryIDExists {
-		return 0, errs.Error{
+		return errs.Error{
 			Err:     fmt.Errorf("category does not exist"),
 			Code:    400,
 			Message: fmt.Sprintf("%s: category does not exist", errs.StatusBadRequestMessage),
 		}
 	}
-	if err != nil {
-		return 0, errs.Error{
-			Err:     fmt.Errorf("check if category exists error: %w", err),
-			Code:    500,
-			Message: errs.StatusInternalServerErrorMessage,
-		}
+
+	return errs.NilError()
+}
+
+func (s *ItemDetailService) Create(ctx context.Context,
+	itemDetail *model.ItemDetail, itemName string,
+) (int, errs.Error) {
+	itemDetail.Currency = normalizeCurrency(itemDetail.Currency, s.currency.Default)
+	itemDetail.VariantName = normalizeVariantName(itemDetail.VariantName)
+	itemDetail.SKU = normalizeCode(itemDetail.SKU)
+	itemDetail.Barcode = normalizeCode(itemDetail.Barcode)
+	if myerr := validateItemDetail(itemDetail, itemName); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	if myerr := s.checkGroupAndCategory(ctx, itemDetail.GroupID, itemDetail.CategoryID); myerr.IsErr() {
+		return 0, myerr
 	}
 
 	// create new item with itemName, if does not exist. otherwise use existing item.
 	// then create new item detail
 	res, err := s.repo.Create(ctx, itemDetail, itemName)
+	if err == errs.ErrVariantExists {
+		return 0, variantExistsError("create item detail", err)
+	}
+	if err == errs.ErrSKUExists || err == errs.ErrBarcodeExists {
+		return 0, codeExistsError("create item detail", err)
+	}
 	if err != nil {
 		return 0, errs.Error{
 			Err:     fmt.Errorf("create item detail error: %w", err),
@@ -120,54 +187,175 @@ func (s *ItemDetailService) Get(ctx context.Context, id int) (*model.ItemDetailV
 		}
 	}
 
-	return itemDetailView, errs.NilError()
+	return s.completeView(ctx, itemDetailView)
 }
 
-func (s *ItemDetailService) GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter) ([]*model.ItemDetailView, errs.Error) {
-	itemDetailViews, err := s.repo.GetAllFilter(ctx, filter)
+// GetByBarcode returns the item detail by EAN-13 or UPC-A barcode, e.g. scanned at the checkout.
+func (s *ItemDetailService) GetByBarcode(ctx context.Context, barcode string) (*model.ItemDetailView, errs.Error) {
+	barcode = strings.TrimSpace(barcode)
+	if !validBarcode(barcode) {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("invalid barcode %q", barcode),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, _invalidBarcodeMessage),
+		}
+	}
+
+	itemDetailView, err := s.repo.GetByBarcode(ctx, barcode)
 	if err == errs.ErrNotFound {
 		return nil, errs.Error{
-			Err:     fmt.Errorf("get all item detail error: %w", err),
+			Err:     fmt.Errorf("get item detail by barcode error: %w", err),
 			Code:    404,
 			Message: errs.StatusNotFoundMessage,
 		}
 	}
 	if err != nil {
 		return nil, errs.Error{
-			Err:     fmt.Errorf("get all item detail error: %w", err),
+			Err:     fmt.Errorf("get item detail by barcode error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
 
-	return itemDetailViews, errs.NilError()
+	return s.completeView(ctx, itemDetailView)
 }
 
-func (s *ItemDetailService) Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) errs.Error {
-	errMsg := ""
-	switch {
-	case itemName == "":
-		errMsg = "item name is empty"
-	case itemDetail.GroupID <= 0:
-		errMsg = "invalid group id"
-	case itemDetail.CategoryID == 0:
-		errMsg = "invalid category id"
-	case itemDetail.Cost <= 0:
-		errMsg = "cost must be greater than 0"
-	case itemDetail.Price <= 0:
-		errMsg = "price must be greater than 0"
-	case itemDetail.Sort <= 0:
-		errMsg = "sort must be greater than 0"
+// completeView adds the modifier groups and the taxes to the single item detail view.
+func (s *ItemDetailService) completeView(ctx context.Context, itemDetailView *model.ItemDetailView) (*model.ItemDetailView, errs.Error) {
+	var err error
+	itemDetailView.ModifierGroups, err = s.modifierGroupRepo.ItemDetailModifierGroups(ctx, itemDetailView.ID)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get item detail modifier groups error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
 	}
-	if errMsg != "" {
+
+	applyTax(itemDetailView, s.currency.Rounding)
+
+	return itemDetailView, errs.NilError()
+}
+
+// validateItemDetailFilter checks margin range, tags and order fields of the filter, default order is the display order.
+func validateItemDetailFilter(filter *model.ItemDetailFilter) errs.Error {
+	if len(filter.OrderBy) == 0 {
+		filter.OrderBy = []model.Order{{Field: model.ItemDetailOrderSort}}
+	}
+	if filter.MinMarginPercent != nil && filter.MaxMarginPercent != nil && filter.MinMarginPercent.GreaterThan(*filter.MaxMarginPercent) {
 		return errs.Error{
-			Err:     errors.New(errMsg),
+			Err:     fmt.Errorf("invalid margin percent range"),
 			Code:    400,
-			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+			Message: fmt.Sprintf("%s: min_margin_percent must not be greater than max_margin_percent", errs.StatusBadRequestMessage),
+		}
+	}
+	if filter.IncludeSubcategories && filter.CategoryID == nil && filter.CategoryName == nil {
+		return errs.Error{
+			Err:     fmt.Errorf("include subcategories without category"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: include_subcategories requires category_id or category_name", errs.StatusBadRequestMessage),
+		}
+	}
+	if myerr := validateTagsFilter(&filter.Tags, &filter.TagsMode); myerr.IsErr() {
+		return myerr
+	}
+	for _, order := range filter.OrderBy {
+		if !slices.Contains(model.ItemDetailOrderFields, order.Field) {
+			return errs.Error{
+				Err:     fmt.Errorf("invalid order field %q", order.Field),
+				Code:    400,
+				Message: fmt.Sprintf("%s: invalid order field %s", errs.StatusBadRequestMessage, order.Field),
+			}
 		}
 	}
 
+	return errs.NilError()
+}
+
+func (s *ItemDetailService) GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemDetailView, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	if myerr := validateItemDetailFilter(filter); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	itemDetailViews, pageInfo, err := s.repo.GetAllFilter(ctx, filter, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all item detail error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all item detail error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	for _, itemDetailView := range itemDetailViews {
+		applyTax(itemDetailView, s.currency.Rounding)
+	}
+
+	return itemDetailViews, pageInfo, errs.NilError()
+}
+
+// GetAllGrouped returns page of items with their item details by filter, the variants of each item together.
+func (s *ItemDetailService) GetAllGrouped(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemVariants, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	if myerr := validateItemDetailFilter(filter); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	items, pageInfo, err := s.repo.GetAllGrouped(ctx, filter, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get grouped item detail error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get grouped item detail error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	for _, item := range items {
+		for _, itemDetailView := range item.Variants {
+			applyTax(itemDetailView, s.currency.Rounding)
+		}
+	}
+
+	return items, pageInfo, errs.NilError()
+}
+
+func (s *ItemDetailService) Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) errs.Error {
+	itemDetail.Currency = normalizeCurrency(itemDetail.Currency, s.currency.Default)
+	itemDetail.VariantName = normalizeVariantName(itemDetail.VariantName)
+	itemDetail.SKU = normalizeCode(itemDetail.SKU)
+	itemDetail.Barcode = normalizeCode(itemDetail.Barcode)
+	if myerr := validateItemDetail(itemDetail, itemName); myerr.IsErr() {
+		return myerr
+	}
+
 	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if item detail exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
 	if !exists {
 		return errs.Error{
 			Err:     fmt.Errorf("item detail does not exist"),
@@ -175,57 +363,168 @@ func (s *ItemDetailService) Update(ctx context.Context, id int, itemName string,
 			Message: errs.StatusNotFoundMessage,
 		}
 	}
-	if err != nil {
+
+	if myerr := s.checkGroupAndCategory(ctx, itemDetail.GroupID, itemDetail.CategoryID); myerr.IsErr() {
+		return myerr
+	}
+
+	err = s.repo.Update(ctx, id, itemName, itemDetail)
+	if err == errs.ErrNotFound {
 		return errs.Error{
-			Err:     fmt.Errorf("check if item detail exists error: %w", err),
-			Code:    500,
-			Message: errs.StatusInternalServerErrorMessage,
+			Err:     fmt.Errorf("update item detail error: %w", err),
+			Code:    404,
+			Message: fmt.Sprintf("%s: item detail does not exist", errs.StatusNotFoundMessage),
 		}
 	}
-
-	groupIDExists, err := s.groupRepo.Exists(ctx, itemDetail.GroupID)
-	if !groupIDExists {
+	if err == errs.ErrVariantExists {
+		return variantExistsError("update item detail", err)
+	}
+	if err == errs.ErrSKUExists || err == errs.ErrBarcodeExists {
+		return codeExistsError("update item detail", err)
+	}
+	if err == errs.ErrUniqueConstraint {
 		return errs.Error{
-			Err:     fmt.Errorf("group does not exist"),
+			Err:     fmt.Errorf("update item detail error: %w", err),
 			Code:    400,
-			Message: fmt.Sprintf("%s: group does not exist", errs.StatusBadRequestMessage),
+			Message: fmt.Sprintf("%s: item name already exists", errs.StatusBadRequestMessage),
 		}
 	}
 	if err != nil {
 		return errs.Error{
-			Err:     fmt.Errorf("check if group exists error: %w", err),
+			Err:     fmt.Errorf("update item detail error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
 
-	categoryIDExists, err := s.categoryRepo.Exists(ctx, itemDetail.CategoryID)
-	if !categoryIDExists {
+	return errs.NilError()
+}
+
+// Patch updates only the item detail fields that are set in the patch.
+func (s *ItemDetailService) Patch(ctx context.Context, id int, patch *model.ItemDetailPatch) errs.Error {
+	if patch.Currency != nil {
+		currency := normalizeCurrency(*patch.Currency, "")
+		patch.Currency = &currency
+	}
+	if patch.VariantName != nil {
+		variantName := strings.TrimSpace(*patch.VariantName)
+		patch.VariantName = &variantName
+	}
+	// empty sku and barcode remove them, so they are trimmed but not dropped
+	if patch.SKU != nil {
+		sku := strings.TrimSpace(*patch.SKU)
+		patch.SKU = &sku
+	}
+	if patch.Barcode != nil {
+		barcode := strings.TrimSpace(*patch.Barcode)
+		patch.Barcode = &barcode
+	}
+
+	errMsg := ""
+	switch {
+	case patch.ItemName != nil && *patch.ItemName == "":
+		errMsg = "item name is empty"
+	case patch.GroupID != nil && *patch.GroupID <= 0:
+		errMsg = "invalid group id"
+	case patch.CategoryID != nil && *patch.CategoryID <= 0:
+		errMsg = "invalid category id"
+	case patch.Cost != nil && *patch.Cost <= 0:
+		errMsg = "cost must be greater than 0"
+	case patch.Price != nil && *patch.Price <= 0:
+		errMsg = "price must be greater than 0"
+	case patch.Sort != nil && *patch.Sort <= 0:
+		errMsg = "sort must be greater than 0"
+	case patch.Currency != nil && !validCurrency(*patch.Currency):
+		errMsg = "invalid currency, expected 3-letter code"
+	case patch.SKU != nil && *patch.SKU != "" && !validSKU(*patch.SKU):
+		errMsg = _invalidSKUMessage
+	case patch.Barcode != nil && *patch.Barcode != "" && !validBarcode(*patch.Barcode):
+		errMsg = _invalidBarcodeMessage
+	}
+	if errMsg != "" {
 		return errs.Error{
-			Err:     fmt.Errorf("category does not exist"),
+			Err:     errors.New(errMsg),
 			Code:    400,
-			Message: fmt.Sprintf("%s: category does not exist", errs.StatusBadRequestMessage),
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
 		}
 	}
+
+	exists, err := s.repo.Exists(ctx, id)
 	if err != nil {
 		return errs.Error{
-			Err:     fmt.Errorf("check if category exists error: %w", err),
+			Err:     fmt.Errorf("check if item detail exists error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
 	}
+	if !exists {
+		return errs.Error{
+			Err:     fmt.Errorf("item detail does not exist"),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
 
-	err = s.repo.Update(ctx, id, itemName, itemDetail)
+	if patch.GroupID != nil {
+		groupIDExists, err := s.groupRepo.Exists(ctx, *patch.GroupID)
+		if err != nil {
+			return errs.Error{
+				Err:     fmt.Errorf("check if group exists error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+		if !groupIDExists {
+			return errs.Error{
+				Err:     fmt.Errorf("group does not exist"),
+				Code:    400,
+				Message: fmt.Sprintf("%s: group does not exist", errs.StatusBadRequestMessage),
+			}
+		}
+	}
+
+	if patch.CategoryID != nil {
+		categoryIDExists, err := s.categoryRepo.Exists(ctx, *patch.CategoryID)
+		if err != nil {
+			return errs.Error{
+				Err:     fmt.Errorf("check if category exists error: %w", err),
+				Code:    500,
+				Message: errs.StatusInternalServerErrorMessage,
+			}
+		}
+		if !categoryIDExists {
+			return errs.Error{
+				Err:     fmt.Errorf("category does not exist"),
+				Code:    400,
+				Message: fmt.Sprintf("%s: category does not exist", errs.StatusBadRequestMessage),
+			}
+		}
+	}
+
+	err = s.repo.Patch(ctx, id, patch)
 	if err == errs.ErrNotFound {
 		return errs.Error{
-			Err:     fmt.Errorf("update item detail error: %w", err),
+			Err:     fmt.Errorf("patch item detail error: %w", err),
 			Code:    404,
 			Message: fmt.Sprintf("%s: item detail does not exist", errs.StatusNotFoundMessage),
 		}
 	}
+	if err == errs.ErrVariantExists {
+		return variantExistsError("patch item detail", err)
+	}
+	if err == errs.ErrSKUExists || err == errs.ErrBarcodeExists {
+		return codeExistsError("patch item detail", err)
+	}
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("patch item detail error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: item name already exists", errs.StatusBadRequestMessage),
+		}
+	}
 	if err != nil {
 		return errs.Error{
-			Err:     fmt.Errorf("update item detail error: %w", err),
+			Err:     fmt.Errorf("patch item detail error: %w", err),
 			Code:    500,
 			Message: errs.StatusInternalServerErrorMessage,
 		}
@@ -252,3 +551,77 @@ func (s *ItemDetailService) Delete(ctx context.Context, id int) errs.Error {
 	}
 	return errs.NilError()
 }
+
+func (s *ItemDetailService) PriceHistory(ctx context.Context, id int, filter *model.PriceHistoryFilter) ([]*model.ItemDetailPrice, errs.Error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("invalid price history range"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: from must be before to", errs.StatusBadRequestMessage),
+		}
+	}
+
+	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("check if item detail exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !exists {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("item detail does not exist"),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+
+	prices, err := s.repo.PriceHistory(ctx, id, filter)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get item detail price history error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return prices, errs.NilError()
+}
+
+// PriceAt returns cost and price of the item detail in effect at the time.
+func (s *ItemDetailService) PriceAt(ctx context.Context, id int, at time.Time) (*model.ItemDetailPrice, errs.Error) {
+	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("check if item detail exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !exists {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("item detail does not exist"),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+
+	price, err := s.repo.PriceAt(ctx, id, at)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get item detail price error: %w", err),
+			Code:    404,
+			Message: fmt.Sprintf("%s: item detail has no price at %s", errs.StatusNotFoundMessage, at.Format(time.RFC3339)),
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get item detail price error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return price, errs.NilError()
+}
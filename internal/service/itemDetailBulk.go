@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+const _maxBulkOps = 1000
+
+// Bulk validates the item detail operations and applies the valid ones in one transaction.
+// In all_or_nothing mode (default) nothing is applied if any operation fails,
+// in best_effort mode the failed operations are skipped.
+// Result of each operation is reported in the order of ops.
+func (s *ItemDetailService) Bulk(ctx context.Context, mode string, ops []*model.ItemDetailBulkOp) ([]*model.ItemDetailBulkResult, errs.Error) {
+	if mode == "" {
+		mode = model.BulkModeAllOrNothing
+	}
+
+	errMsg := ""
+	switch {
+	case mode != model.BulkModeAllOrNothing && mode != model.BulkModeBestEffort:
+		errMsg = "invalid bulk mode"
+	case len(ops) == 0:
+		errMsg = "operations are empty"
+	case len(ops) > _maxBulkOps:
+		errMsg = fmt.Sprintf("too many operations, max is %d", _maxBulkOps)
+	}
+	if errMsg != "" {
+		return nil, errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	results := make([]*model.ItemDetailBulkResult, len(ops))
+	var (
+		validOps     []*model.ItemDetailBulkOp
+		validIndexes []int
+	)
+	for i, op := range ops {
+		results[i] = &model.ItemDetailBulkResult{Index: i, Op: op.Op, ID: op.ID}
+		if myerr := s.validateBulkOp(ctx, op); myerr.IsErr() {
+			failBulkResult(results[i], myerr)
+			continue
+		}
+		validOps = append(validOps, op)
+		validIndexes = append(validIndexes, i)
+	}
+
+	atomic := mode == model.BulkModeAllOrNothing
+	if atomic && len(validOps) < len(ops) {
+		rollBackBulkResults(results)
+		return results, errs.NilError()
+	}
+	if len(validOps) == 0 {
+		return results, errs.NilError()
+	}
+
+	ids, opErrs, err := s.repo.Bulk(ctx, validOps, atomic)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("bulk item detail error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	failed := false
+	for j, i := range validIndexes {
+		if opErrs[j] != nil {
+			failBulkResult(results[i], bulkOpError(opErrs[j]))
+			failed = true
+			continue
+		}
+		results[i].ID = ids[j]
+		results[i].Status = model.BulkStatusApplied
+	}
+	if atomic && failed {
+		rollBackBulkResults(results)
+	}
+
+	return results, errs.NilError()
+}
+
+func (s *ItemDetailService) validateBulkOp(ctx context.Context, op *model.ItemDetailBulkOp) errs.Error {
+	errMsg := ""
+	switch {
+	case op.Op != model.BulkOpCreate && op.Op != model.BulkOpUpdate && op.Op != model.BulkOpDelete:
+		errMsg = "invalid operation"
+	case op.Op != model.BulkOpCreate && op.ID <= 0:
+		errMsg = "invalid item detail id"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+	if op.Op == model.BulkOpDelete {
+		return errs.NilError()
+	}
+
+	op.ItemDetail.Currency = normalizeCurrency(op.ItemDetail.Currency, s.currency.Default)
+	op.ItemDetail.VariantName = normalizeVariantName(op.ItemDetail.VariantName)
+	op.ItemDetail.SKU = normalizeCode(op.ItemDetail.SKU)
+	op.ItemDetail.Barcode = normalizeCode(op.ItemDetail.Barcode)
+	if myerr := validateItemDetail(op.ItemDetail, op.ItemName); myerr.IsErr() {
+		return myerr
+	}
+
+	return s.checkGroupAndCategory(ctx, op.ItemDetail.GroupID, op.ItemDetail.CategoryID)
+}
+
+// bulkOpError converts repo error of the failed operation.
+func bulkOpError(err error) errs.Error {
+	switch err {
+	case errs.ErrNotFound:
+		return errs.Error{
+			Err:     fmt.Errorf("bulk item detail operation error: %w", err),
+			Code:    404,
+			Message: fmt.Sprintf("%s: item detail does not exist", errs.StatusNotFoundMessage),
+		}
+	case errs.ErrVariantExists:
+		return variantExistsError("bulk item detail operation", err)
+	case errs.ErrSKUExists, errs.ErrBarcodeExists:
+		return codeExistsError("bulk item detail operation", err)
+	case errs.ErrUniqueConstraint:
+		return errs.Error{
+			Err:     fmt.Errorf("bulk item detail operation error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: item name already exists", errs.StatusBadRequestMessage),
+		}
+	default:
+		return errs.Error{
+			Err:     fmt.Errorf("bulk item detail operation error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+}
+
+func failBulkResult(result *model.ItemDetailBulkResult, myerr errs.Error) {
+	result.Status = model.BulkStatusFailed
+	result.Code = myerr.Code
+	result.Error = myerr.Message
+}
+
+// rollBackBulkResults marks all not failed operations as rolled back.
+func rollBackBulkResults(results []*model.ItemDetailBulkResult) {
+	for _, result := range results {
+		if result.Status != model.BulkStatusFailed {
+			result.Status = model.BulkStatusRolledBack
+			if result.Op == model.BulkOpCreate {
+				result.ID = 0
+			}
+		}
+	}
+}
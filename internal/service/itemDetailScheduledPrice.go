@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+// checkExists checks that the item detail exists.
+func (s *ItemDetailService) checkExists(ctx context.Context, id int) errs.Error {
+	exists, err := s.repo.Exists(ctx, id)
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if item detail exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !exists {
+		return errs.Error{
+			Err:     fmt.Errorf("item detail does not exist"),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// SchedulePrice schedules cost and price change of the item detail, the change must be in the future.
+func (s *ItemDetailService) SchedulePrice(ctx context.Context, scheduledPrice *model.ItemDetailScheduledPrice) (int, errs.Error) {
+	errMsg := ""
+	switch {
+	case scheduledPrice.Cost <= 0:
+		errMsg = "cost must be greater than 0"
+	case scheduledPrice.Price <= 0:
+		errMsg = "price must be greater than 0"
+	case !scheduledPrice.EffectiveFrom.After(time.Now()):
+		errMsg = "effective_from must be in the future"
+	}
+	if errMsg != "" {
+		return 0, errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	if myerr := s.checkExists(ctx, scheduledPrice.ItemDetailID); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.SchedulePrice(ctx, scheduledPrice)
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("schedule item detail price error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+// ScheduledPrices returns pending cost and price changes of the item detail.
+func (s *ItemDetailService) ScheduledPrices(ctx context.Context, id int) ([]*model.ItemDetailScheduledPrice, errs.Error) {
+	if myerr := s.checkExists(ctx, id); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	scheduledPrices, err := s.repo.ScheduledPrices(ctx, id)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get item detail scheduled prices error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return scheduledPrices, errs.NilError()
+}
+
+// CancelScheduledPrice cancels pending cost and price change of the item detail.
+func (s *ItemDetailService) CancelScheduledPrice(ctx context.Context, id, scheduledPriceID int) errs.Error {
+	err := s.repo.CancelScheduledPrice(ctx, id, scheduledPriceID)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("cancel item detail scheduled price error: %w", err),
+			Code:    404,
+			Message: fmt.Sprintf("%s: pending scheduled price does not exist", errs.StatusNotFoundMessage),
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("cancel item detail scheduled price error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// ApplyScheduledPrices applies cost and price changes effective at the time, it is run by the scheduler.
+func (s *ItemDetailService) ApplyScheduledPrices(ctx context.Context, at time.Time) (int, errs.Error) {
+	applied, err := s.repo.ApplyScheduledPrices(ctx, at)
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("apply item detail scheduled prices error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return applied, errs.NilError()
+}
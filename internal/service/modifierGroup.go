@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+)
+
+type ModifierGroupService struct {
+	repo           repo.ModifierGroup
+	itemDetailRepo repo.ItemDetail
+	categoryRepo   repo.Category
+}
+
+func NewModifierGroupService(repo repo.ModifierGroup, itemDetailRepo repo.ItemDetail, categoryRepo repo.Category) *ModifierGroupService {
+	return &ModifierGroupService{
+		repo:           repo,
+		itemDetailRepo: itemDetailRepo,
+		categoryRepo:   categoryRepo,
+	}
+}
+
+// validateModifierGroup checks modifier group fields of create and update.
+func validateModifierGroup(group *model.ModifierGroup) errs.Error {
+	errMsg := ""
+	switch {
+	case group.ModifierGroupName == "":
+		errMsg = "modifier group name is empty"
+	case group.MinSelections < 0:
+		errMsg = "min selections must not be negative"
+	case group.MaxSelections < 1:
+		errMsg = "max selections must be greater than 0"
+	case group.MaxSelections < group.MinSelections:
+		errMsg = "max selections must not be less than min selections"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ModifierGroupService) Create(ctx context.Context, group *model.ModifierGroup) (int, errs.Error) {
+	if myerr := validateModifierGroup(group); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, group)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create modifier group error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier group name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create modifier group error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *ModifierGroupService) Get(ctx context.Context, id int) (*model.ModifierGroup, errs.Error) {
+	group, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get modifier group error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get modifier group error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return group, errs.NilError()
+}
+
+func (s *ModifierGroupService) GetAll(ctx context.Context, page *model.Page) ([]*model.ModifierGroup, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	groups, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all modifier groups error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all modifier groups error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return groups, pageInfo, errs.NilError()
+}
+
+func (s *ModifierGroupService) Update(ctx context.Context, id int, group *model.ModifierGroup) errs.Error {
+	if myerr := validateModifierGroup(group); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, group)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier group error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier group name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier group error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier group error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ModifierGroupService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete modifier group error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete modifier group error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// validateModifier checks modifier fields of create and update.
+func validateModifier(modifier *model.Modifier) errs.Error {
+	if modifier.ModifierName == "" {
+		return errs.Error{
+			Err:     errors.New("modifier name is empty"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier name is empty", errs.StatusBadRequestMessage),
+		}
+	}
+
+	return errs.NilError()
+}
+
+// checkTargetExists checks that the exists func finds the target of the modifier group route,
+// missing target is reported with the code.
+func checkTargetExists(ctx context.Context, exists func(context.Context, int) (bool, error), id int, target string, code int) errs.Error {
+	ok, err := exists(ctx, id)
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if %s exists error: %w", target, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !ok {
+		message := errs.StatusNotFoundMessage
+		if code == 400 {
+			message = fmt.Sprintf("%s: %s does not exist", errs.StatusBadRequestMessage, target)
+		}
+		return errs.Error{
+			Err:     fmt.Errorf("%s does not exist", target),
+			Code:    code,
+			Message: message,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ModifierGroupService) CreateModifier(ctx context.Context, modifier *model.Modifier) (int, errs.Error) {
+	if myerr := validateModifier(modifier); myerr.IsErr() {
+		return 0, myerr
+	}
+	if myerr := checkTargetExists(ctx, s.repo.Exists, modifier.ModifierGroupID, "modifier group", 404); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.CreateModifier(ctx, modifier)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create modifier error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier name already exists in the group", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create modifier error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *ModifierGroupService) UpdateModifier(ctx context.Context, modifier *model.Modifier) errs.Error {
+	if myerr := validateModifier(modifier); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.UpdateModifier(ctx, modifier)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier name already exists in the group", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update modifier error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *ModifierGroupService) DeleteModifier(ctx context.Context, groupID, modifierID int) errs.Error {
+	err := s.repo.DeleteModifier(ctx, groupID, modifierID)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete modifier error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete modifier error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// attachError converts error of attaching the modifier group to the target.
+func attachError(err error, target string) errs.Error {
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("attach modifier group to %s error: %w", target, err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier group is already attached to the %s", errs.StatusBadRequestMessage, target),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("attach modifier group to %s error: %w", target, err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: modifier group does not exist", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("attach modifier group to %s error: %w", target, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// detachError converts error of detaching the modifier group from the target.
+func detachError(err error, target string) errs.Error {
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("detach modifier group from %s error: %w", target, err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("detach modifier group from %s error: %w", target, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// AttachToItemDetail attaches the modifier group to the item detail,
+// the group must not be attached to it or to its category yet.
+func (s *ModifierGroupService) AttachToItemDetail(ctx context.Context, itemDetailID, groupID int) errs.Error {
+	if myerr := checkTargetExists(ctx, s.itemDetailRepo.Exists, itemDetailID, "item detail", 404); myerr.IsErr() {
+		return myerr
+	}
+	if myerr := checkTargetExists(ctx, s.repo.Exists, groupID, "modifier group", 400); myerr.IsErr() {
+		return myerr
+	}
+
+	return attachError(s.repo.AttachToItemDetail(ctx, itemDetailID, groupID), "item detail or its category")
+}
+
+func (s *ModifierGroupService) DetachFromItemDetail(ctx context.Context, itemDetailID, groupID int) errs.Error {
+	return detachError(s.repo.DetachFromItemDetail(ctx, itemDetailID, groupID), "item detail")
+}
+
+// AttachToCategory attaches the modifier group to all item details of the category,
+// the group must not be attached to the category or directly to any of its item details yet.
+func (s *ModifierGroupService) AttachToCategory(ctx context.Context, categoryID, groupID int) errs.Error {
+	if myerr := checkTargetExists(ctx, s.categoryRepo.Exists, categoryID, "category", 404); myerr.IsErr() {
+		return myerr
+	}
+	if myerr := checkTargetExists(ctx, s.repo.Exists, groupID, "modifier group", 400); myerr.IsErr() {
+		return myerr
+	}
+
+	return attachError(s.repo.AttachToCategory(ctx, categoryID, groupID), "category or its item details")
+}
+
+func (s *ModifierGroupService) DetachFromCategory(ctx context.Context, categoryID, groupID int) errs.Error {
+	return detachError(s.repo.DetachFromCategory(ctx, categoryID, groupID), "category")
+}
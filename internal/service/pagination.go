@@ -0,0 +1,35 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+)
+
+const (
+	_defaultPageLimit = 20
+	_maxPageLimit     = 100
+)
+
+// validatePage checks page limit and sets the default one if it is not set.
+// limit above the max is cut to the max.
+func validatePage(page *model.Page) errs.Error {
+	if page.Limit < 0 {
+		return errs.Error{
+			Err:     errors.New("limit is negative"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: limit must not be negative", errs.StatusBadRequestMessage),
+		}
+	}
+
+	switch {
+	case page.Limit == 0:
+		page.Limit = _defaultPageLimit
+	case page.Limit > _maxPageLimit:
+		page.Limit = _maxPageLimit
+	}
+
+	return errs.NilError()
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+type PromotionService struct {
+	repo             repo.Promotion
+	itemDetailRepo   repo.ItemDetail
+	itemRepo         repo.Item
+	categoryRepo     repo.Category
+	groupRepo        repo.Group
+	exchangeRateRepo repo.ExchangeRate
+	currency         CurrencyOptions
+}
+
+func NewPromotionService(
+	repo repo.Promotion,
+	itemDetailRepo repo.ItemDetail,
+	itemRepo repo.Item,
+	categoryRepo repo.Category,
+	groupRepo repo.Group,
+	exchangeRateRepo repo.ExchangeRate,
+	currency CurrencyOptions,
+) *PromotionService {
+	return &PromotionService{
+		repo:             repo,
+		itemDetailRepo:   itemDetailRepo,
+		itemRepo:         itemRepo,
+		categoryRepo:     categoryRepo,
+		groupRepo:        groupRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		currency:         currency,
+	}
+}
+
+// validatePromotion checks promotion fields of create and update, weekdays are sorted and deduplicated.
+func validatePromotion(promotion *model.Promotion) errs.Error {
+	errMsg := ""
+	switch {
+	case promotion.PromotionName == "":
+		errMsg = "promotion name is empty"
+	case !slices.Contains(model.PromotionTypes, promotion.DiscountType):
+		errMsg = fmt.Sprintf("invalid discount type %s, expected one of %v", promotion.DiscountType, model.PromotionTypes)
+	case promotion.DiscountType == model.PromotionPercent && (promotion.DiscountValue <= 0 || promotion.DiscountValue > 100):
+		errMsg = "percent discount value must be greater than 0 and at most 100"
+	case promotion.DiscountType == model.PromotionFixedAmount && promotion.DiscountValue <= 0:
+		errMsg = "fixed amount discount value must be greater than 0"
+	case !validCurrency(promotion.Currency):
+		errMsg = "invalid currency, expected 3-letter code"
+	case promotion.DiscountType == model.PromotionBuyXGetY && promotion.DiscountValue != 0:
+		errMsg = "buy_x_get_y discount value must be 0"
+	case promotion.DiscountType == model.PromotionBuyXGetY && (promotion.BuyQuantity < 1 || promotion.GetQuantity < 1):
+		errMsg = "buy_x_get_y buy and get quantities must be greater than 0"
+	case promotion.DiscountType != model.PromotionBuyXGetY && (promotion.BuyQuantity != 0 || promotion.GetQuantity != 0):
+		errMsg = "buy and get quantities are only used by buy_x_get_y"
+	case !slices.Contains(model.PromotionTargets, promotion.TargetType):
+		errMsg = fmt.Sprintf("invalid target type %s, expected one of %v", promotion.TargetType, model.PromotionTargets)
+	case promotion.TargetID <= 0:
+		errMsg = "target id must be greater than 0"
+	case promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.EndsAt.After(*promotion.StartsAt):
+		errMsg = "ends_at must be after starts_at"
+	case slices.ContainsFunc(promotion.Weekdays, func(weekday int) bool { return weekday < 0 || weekday > 6 }):
+		errMsg = "weekdays must be between 0 (Sunday) and 6 (Saturday)"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	weekdays := slices.Clone(promotion.Weekdays)
+	slices.Sort(weekdays)
+	promotion.Weekdays = slices.Compact(weekdays)
+	if promotion.Weekdays == nil {
+		promotion.Weekdays = []int{}
+	}
+
+	return errs.NilError()
+}
+
+// checkTarget checks that the item, the category or the group of the promotion exists.
+func (s *PromotionService) checkTarget(ctx context.Context, promotion *model.Promotion) errs.Error {
+	var exists bool
+	var err error
+	switch promotion.TargetType {
+	case model.PromotionTargetItem:
+		exists, err = s.itemRepo.Exists(ctx, promotion.TargetID)
+	case model.PromotionTargetCategory:
+		exists, err = s.categoryRepo.Exists(ctx, promotion.TargetID)
+	case model.PromotionTargetGroup:
+		exists, err = s.groupRepo.Exists(ctx, promotion.TargetID)
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if promotion %s exists error: %w", promotion.TargetType, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !exists {
+		return errs.Error{
+			Err:     fmt.Errorf("promotion %s does not exist", promotion.TargetType),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s does not exist", errs.StatusBadRequestMessage, promotion.TargetType),
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *PromotionService) Create(ctx context.Context, promotion *model.Promotion) (int, errs.Error) {
+	promotion.Currency = normalizeCurrency(promotion.Currency, s.currency.Default)
+	if myerr := validatePromotion(promotion); myerr.IsErr() {
+		return 0, myerr
+	}
+	if myerr := s.checkTarget(ctx, promotion); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, promotion)
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create promotion error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *PromotionService) Get(ctx context.Context, id int) (*model.Promotion, errs.Error) {
+	promotion, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotion error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotion error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return promotion, errs.NilError()
+}
+
+func (s *PromotionService) GetAll(ctx context.Context, filter *model.PromotionFilter, page *model.Page) ([]*model.Promotion, *model.PageInfo, errs.Error) {
+	if filter.TargetType != nil && !slices.Contains(model.PromotionTargets, *filter.TargetType) {
+		errMsg := fmt.Sprintf("invalid target type %s, expected one of %v", *filter.TargetType, model.PromotionTargets)
+		return nil, nil, errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	promotions, pageInfo, err := s.repo.GetAll(ctx, filter, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all promotions error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all promotions error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return promotions, pageInfo, errs.NilError()
+}
+
+func (s *PromotionService) Update(ctx context.Context, id int, promotion *model.Promotion) errs.Error {
+	promotion.Currency = normalizeCurrency(promotion.Currency, s.currency.Default)
+	if myerr := validatePromotion(promotion); myerr.IsErr() {
+		return myerr
+	}
+	if myerr := s.checkTarget(ctx, promotion); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, promotion)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update promotion error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update promotion error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *PromotionService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete promotion error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete promotion error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// PromotionalPrice returns the price of the quantity of the item detail with the promotions active at the time.
+// Weekdays of the promotions are checked in the location of the time,
+// fixed amounts are converted to the item detail currency.
+func (s *PromotionService) PromotionalPrice(ctx context.Context, itemDetailID, quantity int, at time.Time) (*model.PromotionalPrice, errs.Error) {
+	if quantity < 1 {
+		return nil, errs.Error{
+			Err:     errors.New("quantity must be greater than 0"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: quantity must be greater than 0", errs.StatusBadRequestMessage),
+		}
+	}
+
+	itemDetail, err := s.itemDetailRepo.Get(ctx, itemDetailID)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotional price error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotional price error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	promotions, err := s.repo.Active(ctx, itemDetail.ItemID, itemDetail.CategoryID, itemDetail.GroupID, at)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotional price error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	promotions = slices.DeleteFunc(promotions, func(promotion *model.Promotion) bool {
+		return len(promotion.Weekdays) > 0 && !slices.Contains(promotion.Weekdays, int(at.Weekday()))
+	})
+
+	for i, promotion := range promotions {
+		if promotion.DiscountType != model.PromotionFixedAmount {
+			continue
+		}
+		converted, myerr := s.convertFixedAmount(ctx, promotion, itemDetail.Currency)
+		if myerr.IsErr() {
+			return nil, myerr
+		}
+		promotions[i] = converted
+	}
+
+	price := promotionalPrice(itemDetail, quantity, promotions, s.currency.Rounding)
+	price.At = at
+
+	return price, errs.NilError()
+}
+
+// convertFixedAmount returns the fixed_amount promotion with the discount value converted to the currency,
+// rounded by the configured rounding.
+func (s *PromotionService) convertFixedAmount(ctx context.Context, promotion *model.Promotion, currency string) (*model.Promotion, errs.Error) {
+	rate, err := exchangeRate(ctx, s.exchangeRateRepo, promotion.Currency, currency)
+	if err == errs.ErrNotFound {
+		return nil, noExchangeRateError(promotion.Currency, currency)
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get promotion exchange rate error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	converted := *promotion
+	converted.DiscountValue = round(decimal.NewFromFloat(promotion.DiscountValue).Mul(rate), s.currency.Rounding).InexactFloat64()
+	converted.Currency = currency
+
+	return &converted, errs.NilError()
+}
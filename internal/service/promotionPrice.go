@@ -0,0 +1,87 @@
+package service
+
+import (
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+// promotionalPrice returns the price of the quantity of the item detail with the promotions.
+// Stackable promotions are applied together in the given order, the others are applied alone,
+// the variant with the lowest total is used, stackable promotions win a tie.
+func promotionalPrice(itemDetail *model.ItemDetailView, quantity int, promotions []*model.Promotion, rounding model.Rounding) *model.PromotionalPrice {
+	price := itemDetail.Price
+	qty := decimal.NewFromInt(int64(quantity))
+	total := price.Mul(qty)
+
+	var stackable, exclusive []*model.Promotion
+	for _, promotion := range promotions {
+		if promotion.Stackable {
+			stackable = append(stackable, promotion)
+		} else {
+			exclusive = append(exclusive, promotion)
+		}
+	}
+	variants := [][]*model.Promotion{stackable}
+	for _, promotion := range exclusive {
+		variants = append(variants, []*model.Promotion{promotion})
+	}
+
+	best, bestApplied := total, []*model.AppliedPromotion{}
+	for _, variant := range variants {
+		variantTotal, applied := applyPromotions(total, quantity, variant, rounding)
+		if len(applied) > 0 && variantTotal.LessThan(best) {
+			best, bestApplied = variantTotal, applied
+		}
+	}
+
+	return &model.PromotionalPrice{
+		ItemDetailID:      itemDetail.ID,
+		Quantity:          quantity,
+		Currency:          itemDetail.Currency,
+		Price:             price.InexactFloat64(),
+		Total:             total.InexactFloat64(),
+		Discount:          total.Sub(best).InexactFloat64(),
+		PromotionalTotal:  best.InexactFloat64(),
+		PromotionalPrice:  round(best.DivRound(qty, 16), rounding).InexactFloat64(),
+		AppliedPromotions: bestApplied,
+	}
+}
+
+// applyPromotions discounts the total of the quantity by the promotions one after another.
+// Percent is off the discounted total, fixed amount is off each unit, buy_x_get_y makes
+// the free units cost nothing at the discounted unit price. The total does not go below 0,
+// promotions without discount are not applied.
+func applyPromotions(total decimal.Decimal, quantity int, promotions []*model.Promotion, rounding model.Rounding) (decimal.Decimal, []*model.AppliedPromotion) {
+	qty := decimal.NewFromInt(int64(quantity))
+	var applied []*model.AppliedPromotion
+
+	for _, promotion := range promotions {
+		value := decimal.NewFromFloat(promotion.DiscountValue)
+		var discount decimal.Decimal
+		switch promotion.DiscountType {
+		case model.PromotionPercent:
+			discount = round(total.Mul(value).Shift(-2), rounding)
+		case model.PromotionFixedAmount:
+			discount = value.Mul(qty)
+		case model.PromotionBuyXGetY:
+			free := quantity / (promotion.BuyQuantity + promotion.GetQuantity) * promotion.GetQuantity
+			discount = round(total.Mul(decimal.NewFromInt(int64(free))).DivRound(qty, 16), rounding)
+		}
+		if discount.GreaterThan(total) {
+			discount = total
+		}
+		if !discount.IsPositive() {
+			continue
+		}
+
+		total = total.Sub(discount)
+		applied = append(applied, &model.AppliedPromotion{
+			PromotionID:   promotion.ID,
+			PromotionName: promotion.PromotionName,
+			DiscountType:  promotion.DiscountType,
+			Discount:      discount.InexactFloat64(),
+		})
+	}
+
+	return total, applied
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/lmnq/test-thai/internal/errs"
 	"github.com/lmnq/test-thai/internal/model"
 	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/lmnq/test-thai/storage"
 )
 
 type Service struct {
@@ -13,50 +16,186 @@ type Service struct {
 	Category
 	Group
 	ItemDetail
+	Import
+	Export
+	ExchangeRate
+	TaxProfile
+	Promotion
+	ModifierGroup
+	Bundle
+	Translation
+	Tag
+	Image
+	Stock
 }
 
-func New(repo *repo.Repo) *Service {
+func New(repo *repo.Repo, storage storage.Storage, currency CurrencyOptions, locale LocaleOptions, image ImageOptions, stock StockOptions, export ExportOptions) *Service {
 	return &Service{
 		Item:     NewItemService(repo.Item),
-		Category: NewCategoryService(repo.Category),
-		Group:    NewGroupService(repo.Group),
+		Category: NewCategoryService(repo.Category, repo.TaxProfile),
+		Group:    NewGroupService(repo.Group, repo.TaxProfile),
 		ItemDetail: NewItemDetailService(
-			repo.ItemDetail, repo.Item, repo.Group, repo.Category,
+			repo.ItemDetail, repo.Item, repo.Group, repo.Category, repo.ModifierGroup, currency,
 		),
+		Import:       NewImportService(repo.ItemDetail, currency.Default),
+		Export:       NewExportService(repo.ItemDetail, currency.Rounding, locale, export),
+		ExchangeRate: NewExchangeRateService(repo.ExchangeRate, currency.Rounding),
+		TaxProfile:   NewTaxProfileService(repo.TaxProfile),
+		Promotion: NewPromotionService(
+			repo.Promotion, repo.ItemDetail, repo.Item, repo.Category, repo.Group, repo.ExchangeRate, currency,
+		),
+		ModifierGroup: NewModifierGroupService(repo.ModifierGroup, repo.ItemDetail, repo.Category),
+		Bundle:        NewBundleService(repo.Bundle, repo.ItemDetail, repo.ExchangeRate, currency),
+		Translation:   NewTranslationService(repo.Translation, repo.Item, repo.Category, repo.Group, locale),
+		Tag:           NewTagService(repo.Tag, repo.Item),
+		Image:         NewImageService(repo.Image, repo.Item, storage, image),
+		Stock:         NewStockService(repo.Stock, repo.ItemDetail, stock),
 	}
 }
 
 // service interfaces -.
 type (
 	Item interface {
-		Create(ctx context.Context, name string) (int, errs.Error)  // create new item
-		Get(ctx context.Context, id int) (*model.Item, errs.Error)  // get item by id
-		GetAll(ctx context.Context) ([]*model.Item, errs.Error)     // get all items
-		Update(ctx context.Context, id int, name string) errs.Error // update item by id
-		Delete(ctx context.Context, id int) errs.Error              // delete item by id
+		Create(ctx context.Context, name string) (int, errs.Error)                                                           // create new item
+		Get(ctx context.Context, id int) (*model.Item, errs.Error)                                                           // get item by id
+		GetAll(ctx context.Context, filter *model.ItemFilter, page *model.Page) ([]*model.Item, *model.PageInfo, errs.Error) // get page of items by filter
+		Update(ctx context.Context, id int, name string) errs.Error                                                          // update item by id
+		Patch(ctx context.Context, id int, name *string) errs.Error                                                          // partially update item by id
+		Delete(ctx context.Context, id int) errs.Error                                                                       // delete item by id
 	}
 
 	Category interface {
-		Create(ctx context.Context, name string) (int, errs.Error)     // create new category
-		Get(ctx context.Context, id int) (*model.Category, errs.Error) // get category by id
-		GetAll(ctx context.Context) ([]*model.Category, errs.Error)    // get all categories
-		Update(ctx context.Context, id int, name string) errs.Error    // update category by id
-		Delete(ctx context.Context, id int) errs.Error                 // delete category by id
+		Create(ctx context.Context, name string) (int, errs.Error)                                     // create new category
+		Get(ctx context.Context, id int) (*model.Category, errs.Error)                                 // get category by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Category, *model.PageInfo, errs.Error) // get page of categories
+		GetTree(ctx context.Context) ([]*model.CategoryNode, errs.Error)                               // get top level categories with their sub-categories
+		Update(ctx context.Context, id int, name string) errs.Error                                    // update category by id
+		Patch(ctx context.Context, id int, name *string) errs.Error                                    // partially update category by id
+		Delete(ctx context.Context, id int) errs.Error                                                 // delete category by id and move its sub-categories to its parent
+		SetTaxProfile(ctx context.Context, id int, taxProfileID *int) errs.Error                       // attach tax profile to category, nil detaches it
+		SetParent(ctx context.Context, id int, parentID *int) errs.Error                               // move category under parent, nil makes it top level
 	}
 
 	Group interface {
-		Create(ctx context.Context, name string) (int, errs.Error)  // create new group
-		Get(ctx context.Context, id int) (*model.Group, errs.Error) // get group by id
-		GetAll(ctx context.Context) ([]*model.Group, errs.Error)    // get all groups
-		Update(ctx context.Context, id int, name string) errs.Error // update group by id
-		Delete(ctx context.Context, id int) errs.Error              // delete group by id
+		Create(ctx context.Context, name string) (int, errs.Error)                                  // create new group
+		Get(ctx context.Context, id int) (*model.Group, errs.Error)                                 // get group by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Group, *model.PageInfo, errs.Error) // get page of groups
+		Update(ctx context.Context, id int, name string) errs.Error                                 // update group by id
+		Patch(ctx context.Context, id int, name *string) errs.Error                                 // partially update group by id
+		Delete(ctx context.Context, id int) errs.Error                                              // delete group by id
+		SetTaxProfile(ctx context.Context, id int, taxProfileID *int) errs.Error                    // attach tax profile to group, nil detaches it
 	}
 
 	ItemDetail interface {
-		Create(ctx context.Context, itemDetail *model.ItemDetail, itemName string) (int, errs.Error)            // create new item (if needed) and new item detail
-		Get(ctx context.Context, id int) (*model.ItemDetailView, errs.Error)                                    // get item detail by id
-		GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter) ([]*model.ItemDetailView, errs.Error) // get item detail list by filter
-		Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) errs.Error           // update item detail by id
-		Delete(ctx context.Context, id int) errs.Error                                                          // delete item detail by id
+		Create(ctx context.Context, itemDetail *model.ItemDetail, itemName string) (int, errs.Error)                                               // create new item (if needed) and new item detail
+		Get(ctx context.Context, id int) (*model.ItemDetailView, errs.Error)                                                                       // get item detail by id
+		GetByBarcode(ctx context.Context, barcode string) (*model.ItemDetailView, errs.Error)                                                      // get item detail by EAN-13 or UPC-A barcode
+		GetAllFilter(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemDetailView, *model.PageInfo, errs.Error) // get page of item detail list by filter
+		GetAllGrouped(ctx context.Context, filter *model.ItemDetailFilter, page *model.Page) ([]*model.ItemVariants, *model.PageInfo, errs.Error)  // get page of items with their item details by filter
+		Update(ctx context.Context, id int, itemName string, itemDetail *model.ItemDetail) errs.Error                                              // update item detail by id
+		Patch(ctx context.Context, id int, patch *model.ItemDetailPatch) errs.Error                                                                // partially update item detail by id
+		Delete(ctx context.Context, id int) errs.Error                                                                                             // delete item detail by id
+		Bulk(ctx context.Context, mode string, ops []*model.ItemDetailBulkOp) ([]*model.ItemDetailBulkResult, errs.Error)                          // apply create, update and delete operations in one transaction
+		PriceHistory(ctx context.Context, id int, filter *model.PriceHistoryFilter) ([]*model.ItemDetailPrice, errs.Error)                         // get cost and price changes of item detail
+		PriceAt(ctx context.Context, id int, at time.Time) (*model.ItemDetailPrice, errs.Error)                                                    // get cost and price of item detail at the time
+		SchedulePrice(ctx context.Context, scheduledPrice *model.ItemDetailScheduledPrice) (int, errs.Error)                                       // schedule cost and price change of item detail
+		ScheduledPrices(ctx context.Context, id int) ([]*model.ItemDetailScheduledPrice, errs.Error)                                               // get pending cost and price changes of item detail
+		CancelScheduledPrice(ctx context.Context, id, scheduledPriceID int) errs.Error                                                             // cancel pending cost and price change of item detail
+		ApplyScheduledPrices(ctx context.Context, at time.Time) (int, errs.Error)                                                                  // apply pending cost and price changes effective at the time
+	}
+
+	Import interface {
+		ItemDetails(ctx context.Context, file io.Reader, dryRun bool) (*model.ItemDetailImportReport, errs.Error) // create or update item details from csv file
+	}
+
+	Export interface {
+		ItemDetails(ctx context.Context, filter *model.ItemDetailFilter, locale string) (func(fn func(*model.ItemDetailView) error) error, errs.Error) // validate filter and get item detail list stream in the locale
+	}
+
+	ExchangeRate interface {
+		Create(ctx context.Context, rate *model.ExchangeRate) (int, errs.Error)                                   // create new exchange rate
+		Get(ctx context.Context, id int) (*model.ExchangeRate, errs.Error)                                        // get exchange rate by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.ExchangeRate, *model.PageInfo, errs.Error)        // get page of exchange rates
+		Update(ctx context.Context, id int, rate *model.ExchangeRate) errs.Error                                  // update exchange rate by id
+		Delete(ctx context.Context, id int) errs.Error                                                            // delete exchange rate by id
+		ConvertItemDetails(ctx context.Context, currency string, itemDetails ...*model.ItemDetailView) errs.Error // convert cost, price and margin of item details to the currency
+	}
+
+	TaxProfile interface {
+		Create(ctx context.Context, profile *model.TaxProfile) (int, errs.Error)                         // create new tax profile
+		Get(ctx context.Context, id int) (*model.TaxProfile, errs.Error)                                 // get tax profile by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.TaxProfile, *model.PageInfo, errs.Error) // get page of tax profiles
+		Update(ctx context.Context, id int, profile *model.TaxProfile) errs.Error                        // update tax profile by id
+		Delete(ctx context.Context, id int) errs.Error                                                   // delete tax profile by id and detach it from categories and groups
+	}
+
+	Promotion interface {
+		Create(ctx context.Context, promotion *model.Promotion) (int, errs.Error)                                                      // create new promotion
+		Get(ctx context.Context, id int) (*model.Promotion, errs.Error)                                                                // get promotion by id
+		GetAll(ctx context.Context, filter *model.PromotionFilter, page *model.Page) ([]*model.Promotion, *model.PageInfo, errs.Error) // get page of promotions by filter
+		Update(ctx context.Context, id int, promotion *model.Promotion) errs.Error                                                     // update promotion by id
+		Delete(ctx context.Context, id int) errs.Error                                                                                 // delete promotion by id
+		PromotionalPrice(ctx context.Context, itemDetailID, quantity int, at time.Time) (*model.PromotionalPrice, errs.Error)          // get price of the quantity of item detail with the promotions active at the time
+	}
+
+	ModifierGroup interface {
+		Create(ctx context.Context, group *model.ModifierGroup) (int, errs.Error)                           // create new modifier group
+		Get(ctx context.Context, id int) (*model.ModifierGroup, errs.Error)                                 // get modifier group with modifiers by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.ModifierGroup, *model.PageInfo, errs.Error) // get page of modifier groups with modifiers
+		Update(ctx context.Context, id int, group *model.ModifierGroup) errs.Error                          // update modifier group by id
+		Delete(ctx context.Context, id int) errs.Error                                                      // delete modifier group with modifiers by id and detach it
+		CreateModifier(ctx context.Context, modifier *model.Modifier) (int, errs.Error)                     // create new modifier of modifier group
+		UpdateModifier(ctx context.Context, modifier *model.Modifier) errs.Error                            // update modifier of modifier group
+		DeleteModifier(ctx context.Context, groupID, modifierID int) errs.Error                             // delete modifier of modifier group
+		AttachToItemDetail(ctx context.Context, itemDetailID, groupID int) errs.Error                       // attach modifier group to item detail
+		DetachFromItemDetail(ctx context.Context, itemDetailID, groupID int) errs.Error                     // detach modifier group from item detail
+		AttachToCategory(ctx context.Context, categoryID, groupID int) errs.Error                           // attach modifier group to all item details of category
+		DetachFromCategory(ctx context.Context, categoryID, groupID int) errs.Error                         // detach modifier group from category
+	}
+
+	Bundle interface {
+		Create(ctx context.Context, bundle *model.Bundle) (int, errs.Error)                                                      // create new bundle with components
+		Get(ctx context.Context, id int) (*model.Bundle, errs.Error)                                                             // get bundle with components, cost and price by id
+		GetAll(ctx context.Context, filter *model.BundleFilter, page *model.Page) ([]*model.Bundle, *model.PageInfo, errs.Error) // get page of bundles with components, cost and price by filter
+		Update(ctx context.Context, id int, bundle *model.Bundle) errs.Error                                                     // update bundle and replace its components by id
+		Delete(ctx context.Context, id int) errs.Error                                                                           // delete bundle by id
+	}
+
+	Translation interface {
+		Locale(lang, acceptLanguage string) (string, errs.Error)                                                 // select locale of names by lang, else by Accept-Language header
+		Set(ctx context.Context, entity string, id int, translation *model.Translation) errs.Error               // create or replace translation of item, category or group name
+		GetAll(ctx context.Context, entity string, id int) ([]*model.Translation, errs.Error)                    // get translations of item, category or group name
+		Delete(ctx context.Context, entity string, id int, locale string) errs.Error                             // delete translation of item, category or group name
+		LocalizeItems(ctx context.Context, locale string, items ...*model.Item) errs.Error                       // set names of items in the locale
+		LocalizeCategories(ctx context.Context, locale string, categories ...*model.Category) errs.Error         // set names of categories in the locale
+		LocalizeCategoryTree(ctx context.Context, locale string, nodes ...*model.CategoryNode) errs.Error        // set names of categories of the tree in the locale
+		LocalizeGroups(ctx context.Context, locale string, groups ...*model.Group) errs.Error                    // set names of groups in the locale
+		LocalizeItemDetails(ctx context.Context, locale string, itemDetails ...*model.ItemDetailView) errs.Error // set item, category and group names of item details in the locale
+		LocalizeItemVariants(ctx context.Context, locale string, items ...*model.ItemVariants) errs.Error        // set names of items with their item details in the locale
+		LocalizeBundles(ctx context.Context, locale string, bundles ...*model.Bundle) errs.Error                 // set item names of bundle components in the locale
+	}
+
+	Tag interface {
+		Create(ctx context.Context, name string) (int, errs.Error)                                // create new tag
+		Get(ctx context.Context, id int) (*model.Tag, errs.Error)                                 // get tag by id
+		GetAll(ctx context.Context, page *model.Page) ([]*model.Tag, *model.PageInfo, errs.Error) // get page of tags
+		Update(ctx context.Context, id int, name string) errs.Error                               // update tag by id
+		Delete(ctx context.Context, id int) errs.Error                                            // delete tag by id and remove it from items
+		AttachToItem(ctx context.Context, itemID, tagID int) errs.Error                           // add tag to item
+		DetachFromItem(ctx context.Context, itemID, tagID int) errs.Error                         // remove tag from item
+	}
+
+	Image interface {
+		Upload(ctx context.Context, itemID int, file io.Reader) (int, errs.Error)                               // validate and store item image with its thumbnail
+		GetAll(ctx context.Context, itemID int) ([]*model.ItemImage, errs.Error)                                // get images of item in upload order
+		Open(ctx context.Context, itemID, id int, thumbnail bool) (*model.ItemImage, io.ReadCloser, errs.Error) // get image of item with reader of its content or of its thumbnail
+		Delete(ctx context.Context, itemID, id int) errs.Error                                                  // delete image of item with its thumbnail
+	}
+
+	Stock interface {
+		CreateMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, errs.Error)                    // record stock movement of item detail by the negative stock policy
+		Balance(ctx context.Context, itemDetailID int) (*model.StockBalance, errs.Error)                                         // get on-hand quantity of item detail
+		Balances(ctx context.Context, page *model.Page) ([]*model.StockBalance, *model.PageInfo, errs.Error)                     // get page of balances of item details having movements
+		Movements(ctx context.Context, itemDetailID int, page *model.Page) ([]*model.StockMovement, *model.PageInfo, errs.Error) // get page of stock movements of item detail, latest first
 	}
 )
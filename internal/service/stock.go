@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+// StockOptions is the policy of the movements taking the stock balance below zero.
+type StockOptions struct {
+	NegativePolicy string // StockNegativeAllow or StockNegativeReject
+}
+
+// Validate checks the negative stock policy.
+func (o StockOptions) Validate() error {
+	if o.NegativePolicy != model.StockNegativeAllow && o.NegativePolicy != model.StockNegativeReject {
+		return fmt.Errorf("invalid negative stock policy %q", o.NegativePolicy)
+	}
+
+	return nil
+}
+
+// quantities are stored with 3 decimals, e.g. kilograms, and must fit DECIMAL(12,3)
+const (
+	_stockQuantityDecimals = 3
+	_maxStockQuantity      = 1e9
+)
+
+type StockService struct {
+	repo           repo.Stock
+	itemDetailRepo repo.ItemDetail
+	options        StockOptions
+}
+
+func NewStockService(repo repo.Stock, itemDetailRepo repo.ItemDetail, options StockOptions) *StockService {
+	return &StockService{
+		repo:           repo,
+		itemDetailRepo: itemDetailRepo,
+		options:        options,
+	}
+}
+
+// validateStockMovement checks type and quantity of the movement and signs the quantity by the type.
+// Receive, sell and waste quantities are positive, sell and waste remove them.
+// Adjust quantity is the signed correction of the balance.
+func validateStockMovement(movement *model.StockMovement) errs.Error {
+	movement.Note = strings.TrimSpace(movement.Note)
+
+	errMsg := ""
+	switch {
+	case !slices.Contains(model.StockMovementTypes, movement.Type):
+		errMsg = "invalid movement type, expected receive, sell, adjust or waste"
+	case movement.Quantity == 0:
+		errMsg = "quantity must not be 0"
+	case movement.Type != model.StockMovementAdjust && movement.Quantity < 0:
+		errMsg = fmt.Sprintf("quantity of %s must be greater than 0", movement.Type)
+	case math.Abs(movement.Quantity) >= _maxStockQuantity:
+		errMsg = fmt.Sprintf("quantity must be less than %.0f", _maxStockQuantity)
+	case decimal.NewFromFloat(movement.Quantity).Exponent() < -_stockQuantityDecimals:
+		errMsg = fmt.Sprintf("quantity must have at most %d decimals", _stockQuantityDecimals)
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	if movement.Type == model.StockMovementSell || movement.Type == model.StockMovementWaste {
+		movement.Quantity = -movement.Quantity
+	}
+
+	return errs.NilError()
+}
+
+// CreateMovement records the stock movement of the item detail and returns it with the balance after it.
+func (s *StockService) CreateMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, errs.Error) {
+	if myerr := validateStockMovement(movement); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	err := s.repo.CreateMovement(ctx, movement, s.options.NegativePolicy == model.StockNegativeAllow)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("create stock movement error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err == errs.ErrNegativeStock {
+		balance := strconv.FormatFloat(movement.BalanceAfter, 'f', -1, 64)
+		return nil, errs.Error{
+			Err:     fmt.Errorf("create stock movement error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: not enough stock, the balance would be %s", errs.StatusBadRequestMessage, balance),
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("create stock movement error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return movement, errs.NilError()
+}
+
+func (s *StockService) Balance(ctx context.Context, itemDetailID int) (*model.StockBalance, errs.Error) {
+	balance, err := s.repo.Balance(ctx, itemDetailID)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get stock balance error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get stock balance error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return balance, errs.NilError()
+}
+
+func (s *StockService) Balances(ctx context.Context, page *model.Page) ([]*model.StockBalance, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	balances, pageInfo, err := s.repo.Balances(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get stock balances error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get stock balances error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return balances, pageInfo, errs.NilError()
+}
+
+func (s *StockService) Movements(ctx context.Context, itemDetailID int, page *model.Page) ([]*model.StockMovement, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+	if myerr := checkTargetExists(ctx, s.itemDetailRepo.Exists, itemDetailID, "item detail", 404); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	movements, pageInfo, err := s.repo.Movements(ctx, itemDetailID, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get stock movements error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get stock movements error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return movements, pageInfo, errs.NilError()
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+)
+
+type TagService struct {
+	repo     repo.Tag
+	itemRepo repo.Item
+}
+
+func NewTagService(repo repo.Tag, itemRepo repo.Item) *TagService {
+	return &TagService{
+		repo:     repo,
+		itemRepo: itemRepo,
+	}
+}
+
+// normalizeTagName trims and lowercases the tag name, so that tags differing in case are the same tag.
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// validateTagName normalizes and checks tag name of create and update.
+func validateTagName(name *string) errs.Error {
+	*name = normalizeTagName(*name)
+	if *name == "" {
+		return errs.Error{
+			Err:     errors.New("tag name is empty"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: tag name is empty", errs.StatusBadRequestMessage),
+		}
+	}
+
+	return errs.NilError()
+}
+
+// validateTagsFilter normalizes the tag names of the filter and checks its mode, default mode is any.
+// Empty and repeated tag names are dropped.
+func validateTagsFilter(tags *[]string, mode *string) errs.Error {
+	if *mode == "" {
+		*mode = model.TagsModeAny
+	}
+	if *mode != model.TagsModeAny && *mode != model.TagsModeAll {
+		return errs.Error{
+			Err:     fmt.Errorf("invalid tags mode %q", *mode),
+			Code:    400,
+			Message: fmt.Sprintf("%s: tags_mode must be %s or %s", errs.StatusBadRequestMessage, model.TagsModeAny, model.TagsModeAll),
+		}
+	}
+
+	var normalized []string
+	for _, tag := range *tags {
+		tag = normalizeTagName(tag)
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	*tags = normalized
+
+	return errs.NilError()
+}
+
+func (s *TagService) Create(ctx context.Context, name string) (int, errs.Error) {
+	if myerr := validateTagName(&name); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, name)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create tag error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: tag name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create tag error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *TagService) Get(ctx context.Context, id int) (*model.Tag, errs.Error) {
+	tag, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get tag error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get tag error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return tag, errs.NilError()
+}
+
+func (s *TagService) GetAll(ctx context.Context, page *model.Page) ([]*model.Tag, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	tags, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all tags error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all tags error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return tags, pageInfo, errs.NilError()
+}
+
+func (s *TagService) Update(ctx context.Context, id int, name string) errs.Error {
+	if myerr := validateTagName(&name); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, name)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update tag error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: tag name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update tag error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update tag error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *TagService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete tag error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete tag error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *TagService) AttachToItem(ctx context.Context, itemID, tagID int) errs.Error {
+	if myerr := checkTargetExists(ctx, s.itemRepo.Exists, itemID, "item", 404); myerr.IsErr() {
+		return myerr
+	}
+	if myerr := checkTargetExists(ctx, s.repo.Exists, tagID, "tag", 400); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.AttachToItem(ctx, itemID, tagID)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("add tag to item error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: item already has the tag", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("add tag to item error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *TagService) DetachFromItem(ctx context.Context, itemID, tagID int) errs.Error {
+	err := s.repo.DetachFromItem(ctx, itemID, tagID)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("remove tag from item error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("remove tag from item error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
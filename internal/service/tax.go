@@ -0,0 +1,38 @@
+package service
+
+import (
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+// applyTax sets net price, service charge, VAT and gross price of the item detail by its tax profile.
+// Service charge is on the net price, VAT is on the net price with the service charge.
+// Amounts are rounded by the rounding, for tax inclusive price VAT takes the rounding difference,
+// so the parts always add up to the price.
+func applyTax(itemDetail *model.ItemDetailView, rounding model.Rounding) {
+	price := itemDetail.Price
+	net, serviceCharge, vat := price, decimal.Zero, decimal.Zero
+
+	if profile := itemDetail.TaxProfile; profile != nil {
+		one := decimal.NewFromInt(1)
+		serviceChargeRate := profile.ServiceChargePercent.Shift(-2)
+		vatRate := profile.VATPercent.Shift(-2)
+
+		if profile.PriceIncludesTax {
+			// price = net * (1 + service charge rate) * (1 + VAT rate)
+			divisor := one.Add(serviceChargeRate).Mul(one.Add(vatRate))
+			net = round(price.DivRound(divisor, 16), rounding)
+			serviceCharge = round(net.Mul(serviceChargeRate), rounding)
+			vat = price.Sub(net).Sub(serviceCharge)
+		} else {
+			serviceCharge = round(net.Mul(serviceChargeRate), rounding)
+			vat = round(net.Add(serviceCharge).Mul(vatRate), rounding)
+		}
+	}
+
+	itemDetail.NetPrice = net
+	itemDetail.ServiceCharge = serviceCharge
+	itemDetail.VAT = vat
+	itemDetail.TaxAmount = serviceCharge.Add(vat)
+	itemDetail.GrossPrice = net.Add(itemDetail.TaxAmount)
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+	"github.com/shopspring/decimal"
+)
+
+type TaxProfileService struct {
+	repo repo.TaxProfile
+}
+
+func NewTaxProfileService(repo repo.TaxProfile) *TaxProfileService {
+	return &TaxProfileService{repo}
+}
+
+// validateTaxProfile checks tax profile fields of create and update.
+func validateTaxProfile(profile *model.TaxProfile) errs.Error {
+	errMsg := ""
+	switch {
+	case profile.ProfileName == "":
+		errMsg = "profile name is empty"
+	case !validTaxPercent(profile.VATPercent):
+		errMsg = "vat percent must be between 0 and 100 with at most 2 decimals"
+	case !validTaxPercent(profile.ServiceChargePercent):
+		errMsg = "service charge percent must be between 0 and 100 with at most 2 decimals"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	return errs.NilError()
+}
+
+// validTaxPercent checks that the percent fits the DECIMAL(5,2) column without rounding.
+func validTaxPercent(percent decimal.Decimal) bool {
+	return !percent.IsNegative() && !percent.GreaterThan(decimal.NewFromInt(100)) && percent.Equal(percent.Truncate(2))
+}
+
+func (s *TaxProfileService) Create(ctx context.Context, profile *model.TaxProfile) (int, errs.Error) {
+	if myerr := validateTaxProfile(profile); myerr.IsErr() {
+		return 0, myerr
+	}
+
+	id, err := s.repo.Create(ctx, profile)
+	if err == errs.ErrUniqueConstraint {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create tax profile error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: profile name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return 0, errs.Error{
+			Err:     fmt.Errorf("create tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return id, errs.NilError()
+}
+
+func (s *TaxProfileService) Get(ctx context.Context, id int) (*model.TaxProfile, errs.Error) {
+	profile, err := s.repo.Get(ctx, id)
+	if err == errs.ErrNotFound {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get tax profile error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return profile, errs.NilError()
+}
+
+func (s *TaxProfileService) GetAll(ctx context.Context, page *model.Page) ([]*model.TaxProfile, *model.PageInfo, errs.Error) {
+	if myerr := validatePage(page); myerr.IsErr() {
+		return nil, nil, myerr
+	}
+
+	profiles, pageInfo, err := s.repo.GetAll(ctx, page)
+	if errors.Is(err, errs.ErrInvalidCursor) {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all tax profiles error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: invalid cursor", errs.StatusBadRequestMessage),
+		}
+	}
+	if err != nil {
+		return nil, nil, errs.Error{
+			Err:     fmt.Errorf("get all tax profiles error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return profiles, pageInfo, errs.NilError()
+}
+
+func (s *TaxProfileService) Update(ctx context.Context, id int, profile *model.TaxProfile) errs.Error {
+	if myerr := validateTaxProfile(profile); myerr.IsErr() {
+		return myerr
+	}
+
+	err := s.repo.Update(ctx, id, profile)
+	if err == errs.ErrUniqueConstraint {
+		return errs.Error{
+			Err:     fmt.Errorf("update tax profile error: %w", err),
+			Code:    400,
+			Message: fmt.Sprintf("%s: profile name already exists", errs.StatusBadRequestMessage),
+		}
+	}
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("update tax profile error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("update tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *TaxProfileService) Delete(ctx context.Context, id int) errs.Error {
+	err := s.repo.Delete(ctx, id)
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete tax profile error: %w", err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete tax profile error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// checkTaxProfile checks that the tax profile to attach exists, nil profile is valid.
+func checkTaxProfile(ctx context.Context, taxProfileRepo repo.TaxProfile, taxProfileID *int) errs.Error {
+	if taxProfileID == nil {
+		return errs.NilError()
+	}
+
+	exists, err := taxProfileRepo.Exists(ctx, *taxProfileID)
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check if tax profile exists error: %w", err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !exists {
+		return errs.Error{
+			Err:     fmt.Errorf("tax profile does not exist"),
+			Code:    400,
+			Message: fmt.Sprintf("%s: tax profile does not exist", errs.StatusBadRequestMessage),
+		}
+	}
+
+	return errs.NilError()
+}
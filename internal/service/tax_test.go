@@ -0,0 +1,93 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+func TestApplyTax(t *testing.T) {
+	d := decimal.RequireFromString
+	vatOnly := &model.TaxProfile{VATPercent: d("7"), PriceIncludesTax: true}
+	inclusive := &model.TaxProfile{VATPercent: d("7"), ServiceChargePercent: d("10"), PriceIncludesTax: true}
+	exclusive := &model.TaxProfile{VATPercent: d("7"), ServiceChargePercent: d("10")}
+	fractional := &model.TaxProfile{VATPercent: d("7.25"), ServiceChargePercent: d("2.5")}
+
+	tests := []struct {
+		name          string
+		price         string
+		profile       *model.TaxProfile
+		mode          string
+		net           string
+		serviceCharge string
+		vat           string
+		gross         string
+	}{
+		{"no tax profile", "107", nil, model.RoundingHalfUp, "107", "0", "0", "107"},
+		{"inclusive VAT only", "107", vatOnly, model.RoundingHalfUp, "100", "0", "7", "107"},
+		{"inclusive 107.00", "107", inclusive, model.RoundingHalfUp, "90.91", "9.09", "7", "107"},
+		{"inclusive 117.70", "117.70", inclusive, model.RoundingHalfUp, "100", "10", "7.70", "117.70"},
+		{"exclusive 100.00", "100", exclusive, model.RoundingHalfUp, "100", "10", "7.70", "117.70"},
+		{"exclusive 107.00", "107", exclusive, model.RoundingHalfUp, "107", "10.70", "8.24", "125.94"},
+		{"exclusive fractional percents", "0.10", fractional, model.RoundingHalfUp, "0.10", "0", "0.01", "0.11"},
+
+		// 10.05 has halves and remainders, so each mode rounds it differently
+		{"inclusive half_up", "10.05", inclusive, model.RoundingHalfUp, "8.54", "0.85", "0.66", "10.05"},
+		{"inclusive half_even", "10.05", inclusive, model.RoundingHalfEven, "8.54", "0.85", "0.66", "10.05"},
+		{"inclusive ceil", "10.05", inclusive, model.RoundingCeil, "8.54", "0.86", "0.65", "10.05"},
+		{"inclusive floor", "10.05", inclusive, model.RoundingFloor, "8.53", "0.85", "0.67", "10.05"},
+		{"exclusive half_up", "10.05", exclusive, model.RoundingHalfUp, "10.05", "1.01", "0.77", "11.83"},
+		{"exclusive half_even", "10.05", exclusive, model.RoundingHalfEven, "10.05", "1", "0.77", "11.82"},
+		{"exclusive ceil", "10.05", exclusive, model.RoundingCeil, "10.05", "1.01", "0.78", "11.84"},
+		{"exclusive floor", "10.05", exclusive, model.RoundingFloor, "10.05", "1", "0.77", "11.82"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemDetail := &model.ItemDetailView{Price: d(tt.price), TaxProfile: tt.profile}
+			applyTax(itemDetail, model.Rounding{Mode: tt.mode, Decimals: 2})
+
+			got := []decimal.Decimal{itemDetail.NetPrice, itemDetail.ServiceCharge, itemDetail.VAT, itemDetail.GrossPrice}
+			want := []decimal.Decimal{d(tt.net), d(tt.serviceCharge), d(tt.vat), d(tt.gross)}
+			for i := range got {
+				if !got[i].Equal(want[i]) {
+					t.Errorf("net, service charge, VAT, gross = %v, want %v", got, want)
+					break
+				}
+			}
+
+			if tax := itemDetail.ServiceCharge.Add(itemDetail.VAT); !itemDetail.TaxAmount.Equal(tax) {
+				t.Errorf("tax amount %v != service charge + VAT %v", itemDetail.TaxAmount, tax)
+			}
+			if sum := itemDetail.NetPrice.Add(itemDetail.TaxAmount); !sum.Equal(itemDetail.GrossPrice) {
+				t.Errorf("net + tax amount = %v, want gross price %v", sum, itemDetail.GrossPrice)
+			}
+			if (tt.profile == nil || tt.profile.PriceIncludesTax) && !itemDetail.GrossPrice.Equal(d(tt.price)) {
+				t.Errorf("gross price = %v, want the tax inclusive price %v", itemDetail.GrossPrice, tt.price)
+			}
+		})
+	}
+}
+
+func TestValidTaxPercent(t *testing.T) {
+	tests := []struct {
+		percent string
+		want    bool
+	}{
+		{"0", true},
+		{"7", true},
+		{"7.25", true},
+		{"100", true},
+		{"100.00", true},
+		{"-0.01", false},
+		{"100.01", false},
+		{"7.125", false},
+	}
+
+	for _, tt := range tests {
+		if got := validTaxPercent(decimal.RequireFromString(tt.percent)); got != tt.want {
+			t.Errorf("validTaxPercent(%s) = %v, want %v", tt.percent, got, tt.want)
+		}
+	}
+}
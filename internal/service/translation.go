@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lmnq/test-thai/internal/errs"
+	"github.com/lmnq/test-thai/internal/model"
+	"github.com/lmnq/test-thai/internal/repo"
+)
+
+// LocaleOptions are the locales of item, category and group names.
+// The names themselves are in the default locale, the other supported locales are translations.
+type LocaleOptions struct {
+	Default   string
+	Supported []string
+}
+
+// Validate checks that the default locale is supported.
+func (o LocaleOptions) Validate() error {
+	if !slices.Contains(o.Supported, o.Default) {
+		return fmt.Errorf("default locale %q is not supported", o.Default)
+	}
+
+	return nil
+}
+
+type TranslationService struct {
+	repo         repo.Translation
+	itemRepo     repo.Item
+	categoryRepo repo.Category
+	groupRepo    repo.Group
+	locale       LocaleOptions
+}
+
+func NewTranslationService(repo repo.Translation, itemRepo repo.Item, categoryRepo repo.Category, groupRepo repo.Group, locale LocaleOptions) *TranslationService {
+	return &TranslationService{
+		repo:         repo,
+		itemRepo:     itemRepo,
+		categoryRepo: categoryRepo,
+		groupRepo:    groupRepo,
+		locale:       locale,
+	}
+}
+
+// normalizeLocale lowercases the language tag and cuts the region, e.g. zh-CN is zh.
+func normalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	return locale
+}
+
+// acceptedLocales returns the locales of Accept-Language header value by preference,
+// e.g. "zh-CN,zh;q=0.9,en;q=0.8". Locales with q=0 are not acceptable and are skipped.
+func acceptedLocales(acceptLanguage string) []string {
+	type accepted struct {
+		locale string
+		q      float64
+	}
+
+	var list []accepted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		if tag = normalizeLocale(tag); tag == "" || q <= 0 {
+			continue
+		}
+
+		list = append(list, accepted{tag, q})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].q > list[j].q })
+
+	locales := make([]string, 0, len(list))
+	for _, a := range list {
+		locales = append(locales, a.locale)
+	}
+
+	return locales
+}
+
+// Locale selects the locale of the names, lang is used first and must be supported.
+// Otherwise it is the most preferred supported locale of Accept-Language header, else the default locale.
+func (s *TranslationService) Locale(lang, acceptLanguage string) (string, errs.Error) {
+	if lang != "" {
+		locale := normalizeLocale(lang)
+		if !slices.Contains(s.locale.Supported, locale) {
+			return "", unsupportedLocaleError(lang)
+		}
+		return locale, errs.NilError()
+	}
+
+	for _, locale := range acceptedLocales(acceptLanguage) {
+		if locale == "*" {
+			break
+		}
+		if slices.Contains(s.locale.Supported, locale) {
+			return locale, errs.NilError()
+		}
+	}
+
+	return s.locale.Default, errs.NilError()
+}
+
+func unsupportedLocaleError(locale string) errs.Error {
+	return errs.Error{
+		Err:     fmt.Errorf("unsupported locale %q", locale),
+		Code:    400,
+		Message: fmt.Sprintf("%s: unsupported locale %s", errs.StatusBadRequestMessage, locale),
+	}
+}
+
+// exists checks that the translated item, category or group exists.
+func (s *TranslationService) exists(ctx context.Context, entity string, id int) errs.Error {
+	var (
+		ok  bool
+		err error
+	)
+	switch entity {
+	case model.TranslationItem:
+		ok, err = s.itemRepo.Exists(ctx, id)
+	case model.TranslationCategory:
+		ok, err = s.categoryRepo.Exists(ctx, id)
+	case model.TranslationGroup:
+		ok, err = s.groupRepo.Exists(ctx, id)
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("check %s exists error: %w", entity, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+	if !ok {
+		return errs.Error{
+			Err:     fmt.Errorf("%s %d does not exist", entity, id),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// Set creates or replaces the translation of item, category or group name.
+// The name in the default locale is the name itself, it is not translated.
+func (s *TranslationService) Set(ctx context.Context, entity string, id int, translation *model.Translation) errs.Error {
+	locale := normalizeLocale(translation.Locale)
+	if !slices.Contains(s.locale.Supported, locale) {
+		return unsupportedLocaleError(translation.Locale)
+	}
+	translation.Locale = locale
+
+	errMsg := ""
+	switch {
+	case locale == s.locale.Default:
+		errMsg = fmt.Sprintf("%s is the default locale, update the %s name instead", locale, entity)
+	case translation.Name == "":
+		errMsg = "name is empty"
+	}
+	if errMsg != "" {
+		return errs.Error{
+			Err:     errors.New(errMsg),
+			Code:    400,
+			Message: fmt.Sprintf("%s: %s", errs.StatusBadRequestMessage, errMsg),
+		}
+	}
+
+	if myerr := s.exists(ctx, entity, id); myerr.IsErr() {
+		return myerr
+	}
+
+	if err := s.repo.Set(ctx, entity, id, translation); err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("set %s translation error: %w", entity, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+func (s *TranslationService) GetAll(ctx context.Context, entity string, id int) ([]*model.Translation, errs.Error) {
+	if myerr := s.exists(ctx, entity, id); myerr.IsErr() {
+		return nil, myerr
+	}
+
+	translations, err := s.repo.GetAll(ctx, entity, id)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get all %s translations error: %w", entity, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return translations, errs.NilError()
+}
+
+func (s *TranslationService) Delete(ctx context.Context, entity string, id int, locale string) errs.Error {
+	err := s.repo.Delete(ctx, entity, id, normalizeLocale(locale))
+	if err == errs.ErrNotFound {
+		return errs.Error{
+			Err:     fmt.Errorf("delete %s translation error: %w", entity, err),
+			Code:    404,
+			Message: errs.StatusNotFoundMessage,
+		}
+	}
+	if err != nil {
+		return errs.Error{
+			Err:     fmt.Errorf("delete %s translation error: %w", entity, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return errs.NilError()
+}
+
+// names returns the names of the entities in the locale by id.
+// Names in the default locale and names without translation are missing, they stay as they are.
+func (s *TranslationService) names(ctx context.Context, entity, locale string, ids []int) (map[int]string, errs.Error) {
+	if locale == s.locale.Default {
+		return nil, errs.NilError()
+	}
+
+	names, err := s.repo.Names(ctx, entity, locale, ids)
+	if err != nil {
+		return nil, errs.Error{
+			Err:     fmt.Errorf("get %s translations error: %w", entity, err),
+			Code:    500,
+			Message: errs.StatusInternalServerErrorMessage,
+		}
+	}
+
+	return names, errs.NilError()
+}
+
+// LocalizeItems sets the names of the items in the locale.
+func (s *TranslationService) LocalizeItems(ctx context.Context, locale string, items ...*model.Item) errs.Error {
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	names, myerr := s.names(ctx, model.TranslationItem, locale, ids)
+	if myerr.IsErr() {
+		return myerr
+	}
+
+	for _, item := range items {
+		if name, ok := names[item.ID]; ok {
+			item.ItemName = name
+		}
+	}
+
+	return errs.NilError()
+}
+
+// LocalizeCategories sets the names of the categories in the locale.
+func (s *TranslationService) LocalizeCategories(ctx context.Context, locale string, categories ...*model.Category) errs.Error {
+	ids := make([]int, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+	names, myerr := s.names(ctx, model.TranslationCategory, locale, ids)
+	if myerr.IsErr() {
+		return myerr
+	}
+
+	for _, category := range categories {
+		if name, ok := names[category.ID]; ok {
+			category.CategoryName = name
+		}
+	}
+
+	return errs.NilError()
+}
+
+// LocalizeCategoryTree sets the names of the categories of the tree in the locale.
+func (s *TranslationService) LocalizeCategoryTree(ctx context.Context, locale string, nodes ...*model.CategoryNode) errs.Error {
+	var categories []*model.Category
+	var walk func(nodes []*model.CategoryNode)
+	walk = func(nodes []*model.CategoryNode) {
+		for _, node := range nodes {
+			categories = append(categories, node.Category)
+			walk(node.Children)
+		}
+	}
+	walk(nodes)
+
+	return s.LocalizeCategories(ctx, locale, categories...)
+}
+
+// LocalizeGroups sets the names of the groups in the locale.
+func (s *TranslationService) LocalizeGroups(ctx context.Context, locale string, groups ...*model.Group) errs.Error {
+	ids := make([]int, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ID)
+	}
+	names, myerr := s.names(ctx, model.TranslationGroup, locale, ids)
+	if myerr.IsErr() {
+		return myerr
+	}
+
+	for _, group := range groups {
+		if name, ok := names[group.ID]; ok {
+			group.GroupName = name
+		}
+	}
+
+	return errs.NilError()
+}
+
+// LocalizeItemDetails sets the item, category and group names of the item details in the locale.
+func (s *TranslationService) LocalizeItemDetails(ctx context.Context, locale string, itemDetails ...*model.ItemDetailView) errs.Error {
+	if locale == s.locale.Default || len(itemDetails) == 0 {
+		return errs.NilError()
+	}
+
+	itemIDs := make([]int, 0, len(itemDetails))
+	categoryIDs := make([]int, 0, len(itemDetails))
+	groupIDs := make([]int, 0, len(itemDetails))
+	for _, itemDetail := range itemDetails {
+		itemIDs = append(itemIDs, itemDetail.ItemID)
+		categoryIDs = append(categoryIDs, itemDetail.CategoryID)
+		groupIDs = append(groupIDs, itemDetail.GroupID)
+	}
+	itemNames, myerr := s.names(ctx, model.TranslationItem, locale, itemIDs)
+	if myerr.IsErr() {
+		return myerr
+	}
+	categoryNames, myerr := s.names(ctx, model.TranslationCategory, locale, categoryIDs)
+	if myerr.IsErr() {
+		return myerr
+	}
+	groupNames, myerr := s.names(ctx, model.TranslationGroup, locale, groupIDs)
+	if myerr.IsErr() {
+		return myerr
+	}
+
+	for _, itemDetail := range itemDetails {
+		if name, ok := itemNames[itemDetail.ItemID]; ok {
+			itemDetail.ItemName = name
+		}
+		if name, ok := categoryNames[itemDetail.CategoryID]; ok {
+			itemDetail.CategoryName = name
+		}
+		if name, ok := groupNames[itemDetail.GroupID]; ok {
+			itemDetail.GroupName = name
+		}
+	}
+
+	return errs.NilError()
+}
+
+// LocalizeItemVariants sets the names of the items and their item details in the locale.
+func (s *TranslationService) LocalizeItemVariants(ctx context.Context, locale string, items ...*model.ItemVariants) errs.Error {
+	if locale == s.locale.Default || len(items) == 0 {
+		return errs.NilError()
+	}
+
+	var itemDetails []*model.ItemDetailView
+	for _, item := range items {
+		itemDetails = append(itemDetails, item.Variants...)
+	}
+	if myerr := s.LocalizeItemDetails(ctx, locale, itemDetails...); myerr.IsErr() {
+		return myerr
+	}
+
+	// every item has at least one variant, its item name is already localized
+	for _, item := range items {
+		if len(item.Variants) > 0 {
+			item.ItemName = item.Variants[0].ItemName
+		}
+	}
+
+	return errs.NilError()
+}
+
+// LocalizeBundles sets the item names of the bundle components in the locale.
+func (s *TranslationService) LocalizeBundles(ctx context.Context, locale string, bundles ...*model.Bundle) errs.Error {
+	var ids []int
+	for _, bundle := range bundles {
+		for _, component := range bundle.Components {
+			ids = append(ids, component.ItemID)
+		}
+	}
+	names, myerr := s.names(ctx, model.TranslationItem, locale, ids)
+	if myerr.IsErr() {
+		return myerr
+	}
+
+	for _, bundle := range bundles {
+		for _, component := range bundle.Components {
+			if name, ok := names[component.ItemID]; ok {
+				component.ItemName = name
+			}
+		}
+	}
+
+	return errs.NilError()
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_tbl_groups_group_name_trgm";
+DROP INDEX IF EXISTS "idx_tbl_categories_category_name_trgm";
+DROP INDEX IF EXISTS "idx_tbl_items_item_name_trgm";
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,8 @@
+-- trigram indexes for partial and fuzzy name search (ILIKE '%q%', word similarity)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_items_item_name_trgm" ON "tbl_items" USING GIN ("item_name" gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_categories_category_name_trgm" ON "tbl_categories" USING GIN ("category_name" gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_groups_group_name_trgm" ON "tbl_groups" USING GIN ("group_name" gin_trgm_ops);
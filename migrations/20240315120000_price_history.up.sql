@@ -0,0 +1,15 @@
+-- cost and price of the item details over time, a row is added whenever they change
+CREATE TABLE IF NOT EXISTS "tbl_item_detail_prices" (
+    "id" SERIAL PRIMARY KEY,
+    "item_detail_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_detail_id") REFERENCES "tbl_item_details" ("id") ON DELETE CASCADE,
+    "cost" DECIMAL(10,2) NOT NULL,
+    "price" DECIMAL(10,2) NOT NULL,
+    "changed_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_detail_prices_item_detail_id_changed_at" ON "tbl_item_detail_prices" ("item_detail_id", "changed_at");
+
+-- earlier changes are unknown, current prices are known since the last update
+INSERT INTO "tbl_item_detail_prices" ("item_detail_id", "cost", "price", "changed_at")
+SELECT "id", "cost", "price", "updated_at" FROM "tbl_item_details";
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "tbl_exchange_rates";
+ALTER TABLE "tbl_item_detail_prices" DROP COLUMN IF EXISTS "currency";
+ALTER TABLE "tbl_item_details" DROP COLUMN IF EXISTS "currency";
@@ -0,0 +1,16 @@
+-- prices were in the implicit single currency, THB
+ALTER TABLE "tbl_item_details" ADD COLUMN IF NOT EXISTS "currency" CHAR(3) NOT NULL DEFAULT 'THB';
+ALTER TABLE "tbl_item_detail_prices" ADD COLUMN IF NOT EXISTS "currency" CHAR(3) NOT NULL DEFAULT 'THB';
+
+-- rate is the price in quote currency of one unit of base currency
+CREATE TABLE IF NOT EXISTS "tbl_exchange_rates" (
+    "id" SERIAL PRIMARY KEY,
+    "base_currency" CHAR(3) NOT NULL,
+    "quote_currency" CHAR(3) NOT NULL,
+    "rate" DECIMAL(18,8) NOT NULL CHECK ("rate" > 0),
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_exchange_rates_currencies" ON "tbl_exchange_rates" ("base_currency", "quote_currency") WHERE "deleted_at" IS NULL;
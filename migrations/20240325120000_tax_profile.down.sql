@@ -0,0 +1,3 @@
+ALTER TABLE "tbl_groups" DROP COLUMN IF EXISTS "tax_profile_id";
+ALTER TABLE "tbl_categories" DROP COLUMN IF EXISTS "tax_profile_id";
+DROP TABLE IF EXISTS "tbl_tax_profiles";
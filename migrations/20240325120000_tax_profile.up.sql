@@ -0,0 +1,17 @@
+-- tax profile of the item details of a category or a group, the category profile is used first.
+-- service charge is on the net price, VAT is on the net price with the service charge.
+CREATE TABLE IF NOT EXISTS "tbl_tax_profiles" (
+    "id" SERIAL PRIMARY KEY,
+    "profile_name" VARCHAR(255) NOT NULL,
+    "vat_percent" DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK ("vat_percent" >= 0 AND "vat_percent" <= 100),
+    "service_charge_percent" DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK ("service_charge_percent" >= 0 AND "service_charge_percent" <= 100),
+    "price_includes_tax" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_tax_profiles_profile_name" ON "tbl_tax_profiles" ("profile_name") WHERE "deleted_at" IS NULL;
+
+ALTER TABLE "tbl_categories" ADD COLUMN IF NOT EXISTS "tax_profile_id" INTEGER REFERENCES "tbl_tax_profiles" ("id") ON DELETE SET NULL;
+ALTER TABLE "tbl_groups" ADD COLUMN IF NOT EXISTS "tax_profile_id" INTEGER REFERENCES "tbl_tax_profiles" ("id") ON DELETE SET NULL;
@@ -0,0 +1,16 @@
+-- cost and price changes of the item details applied by the scheduler at effective_from.
+-- applied or canceled changes are kept, pending ones have neither timestamp.
+CREATE TABLE IF NOT EXISTS "tbl_item_detail_scheduled_prices" (
+    "id" SERIAL PRIMARY KEY,
+    "item_detail_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_detail_id") REFERENCES "tbl_item_details" ("id") ON DELETE CASCADE,
+    "cost" DECIMAL(10,2) NOT NULL,
+    "price" DECIMAL(10,2) NOT NULL,
+    "effective_from" TIMESTAMPTZ NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "applied_at" TIMESTAMPTZ,
+    "canceled_at" TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_detail_scheduled_prices_pending" ON "tbl_item_detail_scheduled_prices" ("effective_from") WHERE "applied_at" IS NULL AND "canceled_at" IS NULL;
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_detail_scheduled_prices_item_detail_id" ON "tbl_item_detail_scheduled_prices" ("item_detail_id");
@@ -0,0 +1,24 @@
+-- promotion of the item details of an item, a category or a group.
+-- percent and fixed_amount discount the unit price, fixed_amount in the promotion currency, buy_x_get_y gives get_quantity free units of each buy_quantity + get_quantity.
+-- stackable promotions are combined in ascending priority, the others are applied alone, the lowest total wins.
+CREATE TABLE IF NOT EXISTS "tbl_promotions" (
+    "id" SERIAL PRIMARY KEY,
+    "promotion_name" VARCHAR(255) NOT NULL,
+    "discount_type" VARCHAR(16) NOT NULL CHECK ("discount_type" IN ('percent', 'fixed_amount', 'buy_x_get_y')),
+    "discount_value" DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK ("discount_value" >= 0),
+    "currency" CHAR(3) NOT NULL DEFAULT 'THB',
+    "buy_quantity" INTEGER NOT NULL DEFAULT 0 CHECK ("buy_quantity" >= 0),
+    "get_quantity" INTEGER NOT NULL DEFAULT 0 CHECK ("get_quantity" >= 0),
+    "target_type" VARCHAR(16) NOT NULL CHECK ("target_type" IN ('item', 'category', 'group')),
+    "target_id" INTEGER NOT NULL,
+    "starts_at" TIMESTAMP,
+    "ends_at" TIMESTAMP,
+    "weekdays" INTEGER[] NOT NULL DEFAULT '{}',
+    "stackable" BOOLEAN NOT NULL DEFAULT FALSE,
+    "priority" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_promotions_target" ON "tbl_promotions" ("target_type", "target_id") WHERE "deleted_at" IS NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "tbl_category_modifier_groups";
+DROP TABLE IF EXISTS "tbl_item_detail_modifier_groups";
+DROP TABLE IF EXISTS "tbl_modifiers";
+DROP TABLE IF EXISTS "tbl_modifier_groups";
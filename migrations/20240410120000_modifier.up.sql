@@ -0,0 +1,46 @@
+-- modifier groups of the item details, e.g. spice level, with the number of modifiers to select.
+CREATE TABLE IF NOT EXISTS "tbl_modifier_groups" (
+    "id" SERIAL PRIMARY KEY,
+    "modifier_group_name" VARCHAR(255) NOT NULL,
+    "min_selections" INTEGER NOT NULL DEFAULT 0 CHECK ("min_selections" >= 0),
+    "max_selections" INTEGER NOT NULL DEFAULT 1 CHECK ("max_selections" >= 1 AND "max_selections" >= "min_selections"),
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_modifier_groups_modifier_group_name" ON "tbl_modifier_groups" ("modifier_group_name") WHERE "deleted_at" IS NULL;
+
+-- modifiers of the group, price_delta is added to the item detail price, in its currency.
+CREATE TABLE IF NOT EXISTS "tbl_modifiers" (
+    "id" SERIAL PRIMARY KEY,
+    "modifier_group_id" INTEGER NOT NULL,
+    FOREIGN KEY ("modifier_group_id") REFERENCES "tbl_modifier_groups" ("id") ON DELETE CASCADE,
+    "modifier_name" VARCHAR(255) NOT NULL,
+    "price_delta" DECIMAL(10,2) NOT NULL DEFAULT 0,
+    "sort" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_modifiers_modifier_group_id_modifier_name" ON "tbl_modifiers" ("modifier_group_id", "modifier_name") WHERE "deleted_at" IS NULL;
+
+-- modifier groups attached to item details and to all item details of categories.
+CREATE TABLE IF NOT EXISTS "tbl_item_detail_modifier_groups" (
+    "item_detail_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_detail_id") REFERENCES "tbl_item_details" ("id") ON DELETE CASCADE,
+    "modifier_group_id" INTEGER NOT NULL,
+    FOREIGN KEY ("modifier_group_id") REFERENCES "tbl_modifier_groups" ("id") ON DELETE CASCADE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("item_detail_id", "modifier_group_id")
+);
+
+CREATE TABLE IF NOT EXISTS "tbl_category_modifier_groups" (
+    "category_id" INTEGER NOT NULL,
+    FOREIGN KEY ("category_id") REFERENCES "tbl_categories" ("id") ON DELETE CASCADE,
+    "modifier_group_id" INTEGER NOT NULL,
+    FOREIGN KEY ("modifier_group_id") REFERENCES "tbl_modifier_groups" ("id") ON DELETE CASCADE,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("category_id", "modifier_group_id")
+);
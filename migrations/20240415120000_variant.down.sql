@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_tbl_item_details_item_id_variant_name";
+ALTER TABLE "tbl_item_details" DROP COLUMN IF EXISTS "variant_name";
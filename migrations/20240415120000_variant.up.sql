@@ -0,0 +1,4 @@
+-- variant of the item, e.g. small or large, item details without variant name are the item itself.
+ALTER TABLE "tbl_item_details" ADD COLUMN IF NOT EXISTS "variant_name" VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_item_details_item_id_variant_name" ON "tbl_item_details" ("item_id", "variant_name") WHERE "variant_name" IS NOT NULL AND "deleted_at" IS NULL;
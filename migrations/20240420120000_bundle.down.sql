@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "tbl_bundle_items";
+DROP TABLE IF EXISTS "tbl_bundles";
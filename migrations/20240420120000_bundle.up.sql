@@ -0,0 +1,25 @@
+-- bundles of item details sold for one price, e.g. set menus.
+-- price is the manual price in the bundle currency, the sum of the component prices if null.
+CREATE TABLE IF NOT EXISTS "tbl_bundles" (
+    "id" SERIAL PRIMARY KEY,
+    "bundle_name" VARCHAR(255) NOT NULL,
+    "currency" VARCHAR(3) NOT NULL,
+    "price" DECIMAL(10,2) CHECK ("price" > 0),
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_bundles_bundle_name" ON "tbl_bundles" ("bundle_name") WHERE "deleted_at" IS NULL;
+
+-- components of the bundle, item details are kept when they are soft deleted, so the bundle can be flagged.
+CREATE TABLE IF NOT EXISTS "tbl_bundle_items" (
+    "bundle_id" INTEGER NOT NULL,
+    FOREIGN KEY ("bundle_id") REFERENCES "tbl_bundles" ("id") ON DELETE CASCADE,
+    "item_detail_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_detail_id") REFERENCES "tbl_item_details" ("id") ON DELETE CASCADE,
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    PRIMARY KEY ("bundle_id", "item_detail_id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_bundle_items_item_detail_id" ON "tbl_bundle_items" ("item_detail_id");
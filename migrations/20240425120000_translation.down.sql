@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "tbl_group_translations";
+DROP TABLE IF EXISTS "tbl_category_translations";
+DROP TABLE IF EXISTS "tbl_item_translations";
@@ -0,0 +1,41 @@
+-- names of items, categories and groups in the other locales,
+-- the name of the item, category or group itself is in the default locale.
+CREATE TABLE IF NOT EXISTS "tbl_item_translations" (
+    "item_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_id") REFERENCES "tbl_items" ("id") ON DELETE CASCADE,
+    "locale" VARCHAR(8) NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("item_id", "locale")
+);
+
+CREATE TABLE IF NOT EXISTS "tbl_category_translations" (
+    "category_id" INTEGER NOT NULL,
+    FOREIGN KEY ("category_id") REFERENCES "tbl_categories" ("id") ON DELETE CASCADE,
+    "locale" VARCHAR(8) NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("category_id", "locale")
+);
+
+CREATE TABLE IF NOT EXISTS "tbl_group_translations" (
+    "group_id" INTEGER NOT NULL,
+    FOREIGN KEY ("group_id") REFERENCES "tbl_groups" ("id") ON DELETE CASCADE,
+    "locale" VARCHAR(8) NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY ("group_id", "locale")
+);
+
+-- name filters and search of the item detail list match the names in any locale
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_translations_name" ON "tbl_item_translations" ("name");
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_translations_name_trgm" ON "tbl_item_translations" USING GIN ("name" gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_category_translations_name" ON "tbl_category_translations" ("name");
+CREATE INDEX IF NOT EXISTS "idx_tbl_category_translations_name_trgm" ON "tbl_category_translations" USING GIN ("name" gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_group_translations_name" ON "tbl_group_translations" ("name");
+CREATE INDEX IF NOT EXISTS "idx_tbl_group_translations_name_trgm" ON "tbl_group_translations" USING GIN ("name" gin_trgm_ops);
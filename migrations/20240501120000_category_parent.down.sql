@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_tbl_categories_parent_id";
+
+ALTER TABLE "tbl_categories" DROP CONSTRAINT IF EXISTS "chk_tbl_categories_parent_id";
+ALTER TABLE "tbl_categories" DROP COLUMN IF EXISTS "parent_id";
@@ -0,0 +1,11 @@
+-- categories are a tree, e.g. Drinks > Hot > Coffee. top level categories have no parent.
+-- cycles are prevented by the app, a category can't be its own parent.
+ALTER TABLE "tbl_categories" ADD COLUMN IF NOT EXISTS "parent_id" INTEGER REFERENCES "tbl_categories" ("id") ON DELETE SET NULL;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'chk_tbl_categories_parent_id') THEN
+        ALTER TABLE "tbl_categories" ADD CONSTRAINT "chk_tbl_categories_parent_id" CHECK ("parent_id" <> "id");
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_categories_parent_id" ON "tbl_categories" ("parent_id");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "tbl_item_tags";
+DROP TABLE IF EXISTS "tbl_tags";
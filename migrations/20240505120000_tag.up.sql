@@ -0,0 +1,20 @@
+-- free-form labels of items, e.g. signature or new. names are lowercase.
+CREATE TABLE IF NOT EXISTS "tbl_tags" (
+    "id" SERIAL PRIMARY KEY,
+    "tag_name" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMP NOT NULL DEFAULT now(),
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_tags_tag_name" ON "tbl_tags" ("tag_name") WHERE "deleted_at" IS NULL;
+
+CREATE TABLE IF NOT EXISTS "tbl_item_tags" (
+    "item_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_id") REFERENCES "tbl_items" ("id") ON DELETE CASCADE,
+    "tag_id" INTEGER NOT NULL,
+    FOREIGN KEY ("tag_id") REFERENCES "tbl_tags" ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("item_id", "tag_id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_tags_tag_id" ON "tbl_item_tags" ("tag_id");
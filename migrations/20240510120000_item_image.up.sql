@@ -0,0 +1,15 @@
+-- images of items, the image and its thumbnail are stored as blobs by key
+CREATE TABLE IF NOT EXISTS "tbl_item_images" (
+    "id" SERIAL PRIMARY KEY,
+    "item_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_id") REFERENCES "tbl_items" ("id") ON DELETE CASCADE,
+    "content_type" VARCHAR(255) NOT NULL,
+    "size" BIGINT NOT NULL,
+    "width" INTEGER NOT NULL,
+    "height" INTEGER NOT NULL,
+    "storage_key" VARCHAR(1024) NOT NULL,
+    "thumbnail_key" VARCHAR(1024) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_item_images_item_id" ON "tbl_item_images" ("item_id", "id");
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_tbl_item_details_barcode";
+DROP INDEX IF EXISTS "idx_tbl_item_details_sku";
+ALTER TABLE "tbl_item_details" DROP COLUMN IF EXISTS "barcode";
+ALTER TABLE "tbl_item_details" DROP COLUMN IF EXISTS "sku";
@@ -0,0 +1,7 @@
+-- optional stock keeping unit and EAN-13 or UPC-A barcode of the item detail.
+-- UPC-A is EAN-13 with leading zero, so barcodes are unique in their 13-digit form.
+ALTER TABLE "tbl_item_details" ADD COLUMN IF NOT EXISTS "sku" VARCHAR(64);
+ALTER TABLE "tbl_item_details" ADD COLUMN IF NOT EXISTS "barcode" VARCHAR(13);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_item_details_sku" ON "tbl_item_details" ("sku") WHERE "sku" IS NOT NULL AND "deleted_at" IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tbl_item_details_barcode" ON "tbl_item_details" (lpad("barcode", 13, '0')) WHERE "barcode" IS NOT NULL AND "deleted_at" IS NULL;
@@ -0,0 +1,14 @@
+-- ledger of the on-hand quantity changes of item details.
+-- balance_after is the running balance, so the current balance is the balance_after of the last movement.
+CREATE TABLE IF NOT EXISTS "tbl_stock_movements" (
+    "id" SERIAL PRIMARY KEY,
+    "item_detail_id" INTEGER NOT NULL,
+    FOREIGN KEY ("item_detail_id") REFERENCES "tbl_item_details" ("id"),
+    "movement_type" VARCHAR(16) NOT NULL CHECK ("movement_type" IN ('receive', 'sell', 'adjust', 'waste')),
+    "quantity" DECIMAL(12,3) NOT NULL CHECK ("quantity" <> 0),
+    "balance_after" DECIMAL(12,3) NOT NULL,
+    "note" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS "idx_tbl_stock_movements_item_detail_id" ON "tbl_stock_movements" ("item_detail_id", "id");
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// BundleInput is the bundle of create and update, update replaces the components.
+type BundleInput struct {
+	BundleName  string                 `json:"bundle_name"`
+	Currency    string                 `json:"currency,omitempty"` // default currency of the server if empty
+	ManualPrice *float64               `json:"manual_price"`       // price is computed from the components if nil
+	Components  []BundleComponentInput `json:"components"`
+}
+
+type BundleComponentInput struct {
+	ItemDetailID int `json:"item_detail_id"`
+	Quantity     int `json:"quantity"`
+}
+
+// BundleFilter filters the bundle list, nil fields are not used.
+type BundleFilter struct {
+	Unavailable *bool // bundles with deleted component item details
+}
+
+func (f *BundleFilter) addQuery(query url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.Unavailable != nil {
+		query.Set("unavailable", strconv.FormatBool(*f.Unavailable))
+	}
+}
+
+// CreateBundle creates bundle and returns its id.
+func (c *Client) CreateBundle(ctx context.Context, bundle *BundleInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/bundle", bundle)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetBundle(ctx context.Context, id int) (*Bundle, error) {
+	var bundle Bundle
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/bundle", id)}, &bundle); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+func (c *Client) ListBundles(ctx context.Context, filter *BundleFilter, page Page) (*BundlePage, error) {
+	query := page.query()
+	filter.addQuery(query)
+
+	var res BundlePage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/bundle", query: query}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateBundle(ctx context.Context, id int, bundle *BundleInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/bundle", id), bundle)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteBundle(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/bundle", id)}, nil)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateCategory creates category and returns its id.
+func (c *Client) CreateCategory(ctx context.Context, name string) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/category", map[string]string{"category_name": name})
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetCategory(ctx context.Context, id int) (*Category, error) {
+	var category Category
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/category", id)}, &category); err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (c *Client) ListCategories(ctx context.Context, page Page) (*CategoryPage, error) {
+	var res CategoryPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/category", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CategoryTree returns the top level categories with their sub-categories, siblings are ordered by name.
+func (c *Client) CategoryTree(ctx context.Context) ([]*CategoryNode, error) {
+	var res struct {
+		Categories []*CategoryNode `json:"categories"`
+	}
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/category/tree"}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Categories, nil
+}
+
+func (c *Client) UpdateCategory(ctx context.Context, id int, name string) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/category", id), map[string]string{"category_name": name})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// PatchCategory updates category name if it is not nil.
+func (c *Client) PatchCategory(ctx context.Context, id int, name *string) error {
+	req, err := jsonRequest(http.MethodPatch, idPath("/category", id), namePatch("category_name", name))
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteCategory(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/category", id)}, nil)
+}
+
+// SetCategoryParent moves category under the parent, the parent must not be its sub-category.
+func (c *Client) SetCategoryParent(ctx context.Context, id, parentID int) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/category", id)+"/parent", map[string]int{"parent_id": parentID})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// DeleteCategoryParent makes category top level.
+func (c *Client) DeleteCategoryParent(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/category", id) + "/parent"}, nil)
+}
@@ -0,0 +1,164 @@
+// Package client is the Go client of the test-thai HTTP API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	_defaultTimeout   = 30 * time.Second
+	_defaultRetries   = 2
+	_defaultRetryWait = 200 * time.Millisecond
+)
+
+// Client -.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	retries    int
+	retryWait  time.Duration
+	language   string
+}
+
+// New creates client of the API at baseURL, e.g. http://localhost:8080 or https://example.com/api.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("client - New - url.Parse: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("client - New: base url %q must be absolute", baseURL)
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+
+	c := &Client{
+		baseURL:    u,
+		httpClient: &http.Client{Timeout: _defaultTimeout},
+		retries:    _defaultRetries,
+		retryWait:  _defaultRetryWait,
+	}
+
+	// custom options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// request is an API request, body is sent as is with contentType.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+}
+
+// jsonRequest makes request with JSON body of v.
+func jsonRequest(method, path string, v interface{}) (*request, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request body error: %w", err)
+	}
+
+	return &request{
+		method:      method,
+		path:        path,
+		body:        body,
+		contentType: "application/json",
+	}, nil
+}
+
+// idempotent reports if the request can be retried.
+// POST creates and bulk operations are not retried, they could be applied twice.
+func (r *request) idempotent() bool {
+	return r.method != http.MethodPost
+}
+
+// send sends the request and returns the successful response, the caller must close its body.
+// response with status >= 400 is returned as *Error.
+func (c *Client) send(ctx context.Context, req *request) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path += req.path
+	u.RawQuery = req.query.Encode()
+
+	wait := c.retryWait
+	for attempt := 0; ; attempt++ {
+		httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), bytes.NewReader(req.body))
+		if err != nil {
+			return nil, fmt.Errorf("new request error: %w", err)
+		}
+		if req.contentType != "" {
+			httpReq.Header.Set("Content-Type", req.contentType)
+		}
+		if c.language != "" {
+			httpReq.Header.Set("Accept-Language", c.language)
+		}
+
+		resp, err := c.httpClient.Do(httpReq)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			return resp, nil
+		}
+		if err == nil {
+			err = decodeError(resp)
+		}
+
+		if attempt >= c.retries || !req.idempotent() || !retryable(err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// do sends the request and decodes JSON response body to v, if v is not nil.
+func (c *Client) do(ctx context.Context, req *request, v interface{}) error {
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if v == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode response body error: %w", err)
+	}
+
+	return nil
+}
+
+// retryable reports if the request failed because of network error or temporary server error.
+func retryable(err error) bool {
+	if apiErr, ok := err.(*Error); ok {
+		return apiErr.StatusCode >= http.StatusInternalServerError || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	// context errors are returned by the http client wrapped in *url.Error
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err != context.Canceled && urlErr.Err != context.DeadlineExceeded
+	}
+
+	return false
+}
+
+func idPath(prefix string, id int) string {
+	return fmt.Sprintf("%s/%d", prefix, id)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ExchangeRateInput is the exchange rate of create and update,
+// Rate is the price in QuoteCurrency of one unit of BaseCurrency.
+type ExchangeRateInput struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+}
+
+// CreateExchangeRate creates exchange rate and returns its id.
+func (c *Client) CreateExchangeRate(ctx context.Context, rate *ExchangeRateInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/exchange-rate", rate)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetExchangeRate(ctx context.Context, id int) (*ExchangeRate, error) {
+	var rate ExchangeRate
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/exchange-rate", id)}, &rate); err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (c *Client) ListExchangeRates(ctx context.Context, page Page) (*ExchangeRatePage, error) {
+	var res ExchangeRatePage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/exchange-rate", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateExchangeRate(ctx context.Context, id int, rate *ExchangeRateInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/exchange-rate", id), rate)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteExchangeRate(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/exchange-rate", id)}, nil)
+}
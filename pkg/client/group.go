@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateGroup creates group and returns its id.
+func (c *Client) CreateGroup(ctx context.Context, name string) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/group", map[string]string{"group_name": name})
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetGroup(ctx context.Context, id int) (*Group, error) {
+	var group Group
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/group", id)}, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (c *Client) ListGroups(ctx context.Context, page Page) (*GroupPage, error) {
+	var res GroupPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/group", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, id int, name string) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/group", id), map[string]string{"group_name": name})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// PatchGroup updates group name if it is not nil.
+func (c *Client) PatchGroup(ctx context.Context, id int, name *string) error {
+	req, err := jsonRequest(http.MethodPatch, idPath("/group", id), namePatch("group_name", name))
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/group", id)}, nil)
+}
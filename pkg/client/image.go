@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// UploadItemImage uploads jpeg, png or webp image of the item and returns its id.
+// contentType is sent as is, the server detects the type by the content.
+func (c *Client) UploadItemImage(ctx context.Context, itemID int, image io.Reader, contentType string) (int, error) {
+	body, err := io.ReadAll(image)
+	if err != nil {
+		return 0, fmt.Errorf("read image error: %w", err)
+	}
+
+	req := &request{
+		method:      http.MethodPost,
+		path:        idPath("/item", itemID) + "/images",
+		body:        body,
+		contentType: contentType,
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+// ListItemImages returns the images of the item in upload order.
+func (c *Client) ListItemImages(ctx context.Context, itemID int) ([]*ItemImage, error) {
+	var res struct {
+		Images []*ItemImage `json:"images"`
+	}
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/item", itemID) + "/images"}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Images, nil
+}
+
+// DownloadItemImage returns the content of the image, or of its jpeg thumbnail, the caller must close it.
+func (c *Client) DownloadItemImage(ctx context.Context, itemID, imageID int, thumbnail bool) (io.ReadCloser, error) {
+	path := idPath(idPath("/item", itemID)+"/images", imageID)
+	if thumbnail {
+		path += "/thumbnail"
+	}
+
+	resp, err := c.send(ctx, &request{method: http.MethodGet, path: path})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (c *Client) DeleteItemImage(ctx context.Context, itemID, imageID int) error {
+	path := idPath(idPath("/item", itemID)+"/images", imageID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CreateItem creates item and returns its id.
+func (c *Client) CreateItem(ctx context.Context, name string) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/item", map[string]string{"item_name": name})
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetItem(ctx context.Context, id int) (*Item, error) {
+	var item Item
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/item", id)}, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ListItems returns page of items, q searches by partial or fuzzy name, empty q returns all items.
+func (c *Client) ListItems(ctx context.Context, q string, page Page) (*ItemPage, error) {
+	return c.ListItemsFilter(ctx, &ItemFilter{Q: q}, page)
+}
+
+// ListItemsFilter returns page of items by filter.
+func (c *Client) ListItemsFilter(ctx context.Context, filter *ItemFilter, page Page) (*ItemPage, error) {
+	query := page.query()
+	filter.addQuery(query)
+
+	var res ItemPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/item", query: query}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// addQuery adds query params of the filter.
+func (f *ItemFilter) addQuery(query url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.Q != "" {
+		query.Set("q", f.Q)
+	}
+	addTagsQuery(query, f.Tags, f.TagsMode)
+}
+
+func (c *Client) UpdateItem(ctx context.Context, id int, name string) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/item", id), map[string]string{"item_name": name})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// PatchItem updates item name if it is not nil.
+func (c *Client) PatchItem(ctx context.Context, id int, name *string) error {
+	req, err := jsonRequest(http.MethodPatch, idPath("/item", id), namePatch("item_name", name))
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteItem(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/item", id)}, nil)
+}
+
+func (p Page) query() url.Values {
+	query := url.Values{}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		query.Set("cursor", p.Cursor)
+	}
+
+	return query
+}
+
+// namePatch is the patch body of the name field, absent if name is nil.
+func namePatch(field string, name *string) map[string]string {
+	patch := map[string]string{}
+	if name != nil {
+		patch[field] = *name
+	}
+
+	return patch
+}
+
+// addTagsQuery adds comma separated tags param and its mode if there are tags.
+func addTagsQuery(query url.Values, tags []string, mode string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	query.Set("tags", strings.Join(tags, ","))
+	if mode != "" {
+		query.Set("tags_mode", mode)
+	}
+}
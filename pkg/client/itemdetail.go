@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CreateItemDetail creates item detail and returns its id.
+func (c *Client) CreateItemDetail(ctx context.Context, itemDetail *ItemDetailInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/item-detail", itemDetail)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+// GetItemDetail returns item detail, with prices converted to currency if it is not empty.
+func (c *Client) GetItemDetail(ctx context.Context, id int, currency string) (*ItemDetailView, error) {
+	query := url.Values{}
+	if currency != "" {
+		query.Set("currency", currency)
+	}
+
+	var itemDetail ItemDetailView
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/item-detail", id), query: query}, &itemDetail); err != nil {
+		return nil, err
+	}
+
+	return &itemDetail, nil
+}
+
+// GetItemDetailByBarcode returns item detail by EAN-13 or UPC-A barcode, with prices converted to currency if it is not empty.
+func (c *Client) GetItemDetailByBarcode(ctx context.Context, barcode, currency string) (*ItemDetailView, error) {
+	query := url.Values{}
+	if currency != "" {
+		query.Set("currency", currency)
+	}
+
+	var itemDetail ItemDetailView
+	path := "/item-detail/by-barcode/" + url.PathEscape(barcode)
+	if err := c.do(ctx, &request{method: http.MethodGet, path: path, query: query}, &itemDetail); err != nil {
+		return nil, err
+	}
+
+	return &itemDetail, nil
+}
+
+// ListItemDetails returns page of item details by filter, filter can be nil.
+func (c *Client) ListItemDetails(ctx context.Context, filter *ItemDetailFilter, page Page) (*ItemDetailPage, error) {
+	query := page.query()
+	filter.addQuery(query)
+	if filter != nil && filter.Currency != "" {
+		query.Set("currency", filter.Currency)
+	}
+
+	var res ItemDetailPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/item-detail", query: query}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListItemDetailsGrouped returns page of items with their item details by filter, filter can be nil.
+// Variants of each item are together, the page is a page of items.
+func (c *Client) ListItemDetailsGrouped(ctx context.Context, filter *ItemDetailFilter, page Page) (*ItemVariantsPage, error) {
+	query := page.query()
+	filter.addQuery(query)
+	if filter != nil && filter.Currency != "" {
+		query.Set("currency", filter.Currency)
+	}
+
+	var res ItemVariantsPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/item-detail/grouped", query: query}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateItemDetail(ctx context.Context, id int, itemDetail *ItemDetailInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/item-detail", id), itemDetail)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) PatchItemDetail(ctx context.Context, id int, patch *ItemDetailPatch) error {
+	req, err := jsonRequest(http.MethodPatch, idPath("/item-detail", id), patch)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteItemDetail(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/item-detail", id)}, nil)
+}
+
+// BulkItemDetails applies create, update and delete operations in one transaction.
+// mode is BulkModeAllOrNothing or BulkModeBestEffort, empty mode is BulkModeAllOrNothing.
+// failed operations are reported in the results, they are not returned as error.
+func (c *Client) BulkItemDetails(ctx context.Context, mode string, ops []*ItemDetailBulkOp) (*ItemDetailBulkResponse, error) {
+	req, err := jsonRequest(http.MethodPost, "/item-detail/bulk", map[string]interface{}{
+		"mode":       mode,
+		"operations": ops,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ItemDetailBulkResponse
+	if err := c.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// addQuery adds query params of the filter.
+func (f *ItemDetailFilter) addQuery(query url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.ID != nil {
+		query.Set("id", strconv.Itoa(*f.ID))
+	}
+	if f.Q != nil {
+		query.Set("q", *f.Q)
+	}
+	if f.ItemName != nil {
+		query.Set("item_name", *f.ItemName)
+	}
+	if f.CategoryID != nil {
+		query.Set("category_id", strconv.Itoa(*f.CategoryID))
+	}
+	if f.CategoryName != nil {
+		query.Set("category_name", *f.CategoryName)
+	}
+	if f.IncludeSubcategories {
+		query.Set("include_subcategories", "true")
+	}
+	if f.GroupName != nil {
+		query.Set("group_name", *f.GroupName)
+	}
+	addTagsQuery(query, f.Tags, f.TagsMode)
+	if f.MinMarginPercent != nil {
+		query.Set("min_margin_percent", strconv.FormatFloat(*f.MinMarginPercent, 'f', -1, 64))
+	}
+	if f.MaxMarginPercent != nil {
+		query.Set("max_margin_percent", strconv.FormatFloat(*f.MaxMarginPercent, 'f', -1, 64))
+	}
+	if len(f.OrderBy) > 0 {
+		keys := make([]string, 0, len(f.OrderBy))
+		for _, order := range f.OrderBy {
+			key := order.Field
+			if order.Desc {
+				key += ":desc"
+			}
+			keys = append(keys, key)
+		}
+		query.Set("order_by", strings.Join(keys, ","))
+	}
+}
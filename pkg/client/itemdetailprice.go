@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ItemDetailPriceHistory returns cost and price changes of the item detail in [from, to), nil bound is open.
+func (c *Client) ItemDetailPriceHistory(ctx context.Context, id int, from, to *time.Time) ([]*ItemDetailPrice, error) {
+	query := url.Values{}
+	if from != nil {
+		query.Set("from", from.Format(time.RFC3339Nano))
+	}
+	if to != nil {
+		query.Set("to", to.Format(time.RFC3339Nano))
+	}
+
+	var res struct {
+		Prices []*ItemDetailPrice `json:"prices"`
+	}
+	req := &request{method: http.MethodGet, path: idPath("/item-detail", id) + "/price-history", query: query}
+	if err := c.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Prices, nil
+}
+
+// ItemDetailPriceAt returns cost and price of the item detail in effect at the time.
+func (c *Client) ItemDetailPriceAt(ctx context.Context, id int, at time.Time) (*ItemDetailPrice, error) {
+	query := url.Values{"at": {at.Format(time.RFC3339Nano)}}
+
+	var price ItemDetailPrice
+	req := &request{method: http.MethodGet, path: idPath("/item-detail", id) + "/price", query: query}
+	if err := c.do(ctx, req, &price); err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+// ItemDetailPromotionalPrice returns the price of the quantity of item detail with the promotions active at the time.
+func (c *Client) ItemDetailPromotionalPrice(ctx context.Context, id, quantity int, at time.Time) (*PromotionalPrice, error) {
+	query := url.Values{
+		"quantity": {strconv.Itoa(quantity)},
+		"at":       {at.Format(time.RFC3339Nano)},
+	}
+
+	var price PromotionalPrice
+	req := &request{method: http.MethodGet, path: idPath("/item-detail", id) + "/promotional-price", query: query}
+	if err := c.do(ctx, req, &price); err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+// ScheduleItemDetailPrice schedules cost and price change of the item detail at effectiveFrom
+// and returns id of the scheduled change.
+func (c *Client) ScheduleItemDetailPrice(ctx context.Context, id int, cost, price float64, effectiveFrom time.Time) (int, error) {
+	req, err := jsonRequest(http.MethodPost, idPath("/item-detail", id)+"/scheduled-prices", map[string]interface{}{
+		"cost":           cost,
+		"price":          price,
+		"effective_from": effectiveFrom,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+// ItemDetailScheduledPrices returns pending cost and price changes of the item detail.
+func (c *Client) ItemDetailScheduledPrices(ctx context.Context, id int) ([]*ItemDetailScheduledPrice, error) {
+	var res struct {
+		ScheduledPrices []*ItemDetailScheduledPrice `json:"scheduled_prices"`
+	}
+	req := &request{method: http.MethodGet, path: idPath("/item-detail", id) + "/scheduled-prices"}
+	if err := c.do(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.ScheduledPrices, nil
+}
+
+// CancelItemDetailScheduledPrice cancels pending cost and price change of the item detail.
+func (c *Client) CancelItemDetailScheduledPrice(ctx context.Context, id, scheduledPriceID int) error {
+	path := idPath(idPath("/item-detail", id)+"/scheduled-prices", scheduledPriceID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
@@ -0,0 +1,240 @@
+package client
+
+import "github.com/lmnq/test-thai/internal/model"
+
+// API types, shared with the server.
+type (
+	Item                     = model.Item
+	Category                 = model.Category
+	CategoryNode             = model.CategoryNode
+	Group                    = model.Group
+	ItemDetailView           = model.ItemDetailView
+	ItemVariants             = model.ItemVariants
+	ItemDetailPrice          = model.ItemDetailPrice
+	ItemDetailScheduledPrice = model.ItemDetailScheduledPrice
+	ExchangeRate             = model.ExchangeRate
+	TaxProfile               = model.TaxProfile
+	Promotion                = model.Promotion
+	PromotionalPrice         = model.PromotionalPrice
+	AppliedPromotion         = model.AppliedPromotion
+	ModifierGroup            = model.ModifierGroup
+	Modifier                 = model.Modifier
+	Bundle                   = model.Bundle
+	BundleComponent          = model.BundleComponent
+	Translation              = model.Translation
+	Tag                      = model.Tag
+	ItemImage                = model.ItemImage
+	ImageLink                = model.ImageLink
+	StockMovement            = model.StockMovement
+	StockBalance             = model.StockBalance
+	Order                    = model.Order
+	ItemDetailBulkResult     = model.ItemDetailBulkResult
+	ItemDetailImportReport   = model.ItemDetailImportReport
+	ItemDetailImportResult   = model.ItemDetailImportResult
+	ItemDetailImportValues   = model.ItemDetailImportValues
+)
+
+// item detail list order fields
+const (
+	ItemDetailOrderSort         = model.ItemDetailOrderSort
+	ItemDetailOrderPrice        = model.ItemDetailOrderPrice
+	ItemDetailOrderCost         = model.ItemDetailOrderCost
+	ItemDetailOrderItemName     = model.ItemDetailOrderItemName
+	ItemDetailOrderCategoryName = model.ItemDetailOrderCategoryName
+	ItemDetailOrderGroupName    = model.ItemDetailOrderGroupName
+	ItemDetailOrderCreatedAt    = model.ItemDetailOrderCreatedAt
+	ItemDetailOrderUpdatedAt    = model.ItemDetailOrderUpdatedAt
+)
+
+// bulk operations, modes and result statuses
+const (
+	BulkOpCreate = model.BulkOpCreate
+	BulkOpUpdate = model.BulkOpUpdate
+	BulkOpDelete = model.BulkOpDelete
+
+	BulkModeAllOrNothing = model.BulkModeAllOrNothing
+	BulkModeBestEffort   = model.BulkModeBestEffort
+
+	BulkStatusApplied    = model.BulkStatusApplied
+	BulkStatusFailed     = model.BulkStatusFailed
+	BulkStatusRolledBack = model.BulkStatusRolledBack
+)
+
+// promotion discount and target types
+const (
+	PromotionPercent     = model.PromotionPercent
+	PromotionFixedAmount = model.PromotionFixedAmount
+	PromotionBuyXGetY    = model.PromotionBuyXGetY
+
+	PromotionTargetItem     = model.PromotionTargetItem
+	PromotionTargetCategory = model.PromotionTargetCategory
+	PromotionTargetGroup    = model.PromotionTargetGroup
+)
+
+// tag filter modes
+const (
+	TagsModeAny = model.TagsModeAny
+	TagsModeAll = model.TagsModeAll
+)
+
+// stock movement types
+const (
+	StockMovementReceive = model.StockMovementReceive
+	StockMovementSell    = model.StockMovementSell
+	StockMovementAdjust  = model.StockMovementAdjust
+	StockMovementWaste   = model.StockMovementWaste
+)
+
+// Page selects a page of the list, zero Limit means the server default.
+// Cursor is NextCursor of the previous page, empty for the first page.
+type Page struct {
+	Limit  int
+	Cursor string
+}
+
+type ItemPage struct {
+	Items      []*Item `json:"items"`
+	NextCursor *string `json:"next_cursor"` // nil on the last page
+	Total      int     `json:"total"`
+}
+
+type CategoryPage struct {
+	Categories []*Category `json:"categories"`
+	NextCursor *string     `json:"next_cursor"` // nil on the last page
+	Total      int         `json:"total"`
+}
+
+type GroupPage struct {
+	Groups     []*Group `json:"groups"`
+	NextCursor *string  `json:"next_cursor"` // nil on the last page
+	Total      int      `json:"total"`
+}
+
+type ExchangeRatePage struct {
+	ExchangeRates []*ExchangeRate `json:"exchange_rates"`
+	NextCursor    *string         `json:"next_cursor"` // nil on the last page
+	Total         int             `json:"total"`
+}
+
+type TaxProfilePage struct {
+	TaxProfiles []*TaxProfile `json:"tax_profiles"`
+	NextCursor  *string       `json:"next_cursor"` // nil on the last page
+	Total       int           `json:"total"`
+}
+
+type PromotionPage struct {
+	Promotions []*Promotion `json:"promotions"`
+	NextCursor *string      `json:"next_cursor"` // nil on the last page
+	Total      int          `json:"total"`
+}
+
+type ModifierGroupPage struct {
+	ModifierGroups []*ModifierGroup `json:"modifier_groups"`
+	NextCursor     *string          `json:"next_cursor"` // nil on the last page
+	Total          int              `json:"total"`
+}
+
+type TagPage struct {
+	Tags       []*Tag  `json:"tags"`
+	NextCursor *string `json:"next_cursor"` // nil on the last page
+	Total      int     `json:"total"`
+}
+
+type StockMovementPage struct {
+	Movements  []*StockMovement `json:"movements"`
+	NextCursor *string          `json:"next_cursor"` // nil on the last page
+	Total      int              `json:"total"`
+}
+
+type StockBalancePage struct {
+	Balances   []*StockBalance `json:"balances"`
+	NextCursor *string         `json:"next_cursor"` // nil on the last page
+	Total      int             `json:"total"`
+}
+
+type BundlePage struct {
+	Bundles    []*Bundle `json:"bundles"`
+	NextCursor *string   `json:"next_cursor"` // nil on the last page
+	Total      int       `json:"total"`
+}
+
+type ItemDetailPage struct {
+	ItemDetails []*ItemDetailView `json:"item_details"`
+	NextCursor  *string           `json:"next_cursor"` // nil on the last page
+	Total       int               `json:"total"`
+}
+
+// ItemVariantsPage is a page of items with their item details, Total is the number of items.
+type ItemVariantsPage struct {
+	Items      []*ItemVariants `json:"items"`
+	NextCursor *string         `json:"next_cursor"` // nil on the last page
+	Total      int             `json:"total"`
+}
+
+// ItemDetailInput is the item detail of create and update, the item is created by name if it does not exist.
+type ItemDetailInput struct {
+	ItemName    string  `json:"item_name"`
+	VariantName *string `json:"variant_name,omitempty"` // e.g. small or large, not a variant if nil
+	SKU         *string `json:"sku,omitempty"`
+	Barcode     *string `json:"barcode,omitempty"` // EAN-13 or UPC-A
+	GroupID     int     `json:"group_id"`
+	CategoryID  int     `json:"category_id"`
+	Cost        float64 `json:"cost"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency,omitempty"` // default currency of the server if empty
+	Sort        int     `json:"sort"`
+}
+
+// ItemDetailPatch is a partial item detail update, nil fields are left unchanged.
+type ItemDetailPatch struct {
+	ItemName    *string  `json:"item_name,omitempty"`
+	VariantName *string  `json:"variant_name,omitempty"` // empty makes the item detail not a variant
+	SKU         *string  `json:"sku,omitempty"`          // empty removes the sku
+	Barcode     *string  `json:"barcode,omitempty"`      // empty removes the barcode
+	GroupID     *int     `json:"group_id,omitempty"`
+	CategoryID  *int     `json:"category_id,omitempty"`
+	Cost        *float64 `json:"cost,omitempty"`
+	Price       *float64 `json:"price,omitempty"`
+	Currency    *string  `json:"currency,omitempty"`
+	Sort        *int     `json:"sort,omitempty"`
+}
+
+// ItemFilter filters the item list, empty fields are not used.
+type ItemFilter struct {
+	Q        string   // partial or fuzzy item name search
+	Tags     []string // tag names
+	TagsMode string   // TagsModeAny or TagsModeAll, any by default
+}
+
+// ItemDetailFilter filters the item detail list and export, nil fields are not used.
+// OrderBy is the display order by default.
+// Currency converts the prices of the list, it is not used by export.
+type ItemDetailFilter struct {
+	ID                   *int
+	Q                    *string // partial or fuzzy item, category and group name search
+	ItemName             *string
+	CategoryID           *int
+	CategoryName         *string
+	IncludeSubcategories bool // CategoryID and CategoryName match the sub-categories too
+	GroupName            *string
+	Tags                 []string // tag names of the item
+	TagsMode             string   // TagsModeAny or TagsModeAll, any by default
+	MinMarginPercent     *float64
+	MaxMarginPercent     *float64
+	OrderBy              []Order
+	Currency             string
+}
+
+// ItemDetailBulkOp is an operation of the bulk request.
+// ID is used by update and delete, the item detail fields by create and update.
+type ItemDetailBulkOp struct {
+	Op string `json:"op"`
+	ID int    `json:"id,omitempty"`
+	ItemDetailInput
+}
+
+type ItemDetailBulkResponse struct {
+	Applied int                     `json:"applied"`
+	Failed  int                     `json:"failed"`
+	Results []*ItemDetailBulkResult `json:"results"`
+}
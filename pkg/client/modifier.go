@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ModifierGroupInput is the modifier group of create and update.
+type ModifierGroupInput struct {
+	ModifierGroupName string `json:"modifier_group_name"`
+	MinSelections     int    `json:"min_selections"`
+	MaxSelections     int    `json:"max_selections"`
+}
+
+// ModifierInput is the modifier of create and update.
+type ModifierInput struct {
+	ModifierName string  `json:"modifier_name"`
+	PriceDelta   float64 `json:"price_delta"` // added to the item detail price, in its currency
+	Sort         int     `json:"sort"`
+}
+
+// CreateModifierGroup creates modifier group and returns its id.
+func (c *Client) CreateModifierGroup(ctx context.Context, group *ModifierGroupInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/modifier-group", group)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetModifierGroup(ctx context.Context, id int) (*ModifierGroup, error) {
+	var group ModifierGroup
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/modifier-group", id)}, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (c *Client) ListModifierGroups(ctx context.Context, page Page) (*ModifierGroupPage, error) {
+	var res ModifierGroupPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/modifier-group", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateModifierGroup(ctx context.Context, id int, group *ModifierGroupInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/modifier-group", id), group)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// DeleteModifierGroup deletes modifier group with its modifiers and detaches it from item details and categories.
+func (c *Client) DeleteModifierGroup(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/modifier-group", id)}, nil)
+}
+
+// CreateModifier creates modifier of the modifier group and returns its id.
+func (c *Client) CreateModifier(ctx context.Context, groupID int, modifier *ModifierInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, idPath("/modifier-group", groupID)+"/modifiers", modifier)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) UpdateModifier(ctx context.Context, groupID, modifierID int, modifier *ModifierInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath(idPath("/modifier-group", groupID)+"/modifiers", modifierID), modifier)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteModifier(ctx context.Context, groupID, modifierID int) error {
+	path := idPath(idPath("/modifier-group", groupID)+"/modifiers", modifierID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
+
+// AttachItemDetailModifierGroup attaches modifier group to item detail, it must not be attached to its category.
+func (c *Client) AttachItemDetailModifierGroup(ctx context.Context, itemDetailID, groupID int) error {
+	return c.attachModifierGroup(ctx, idPath("/item-detail", itemDetailID)+"/modifier-groups", groupID)
+}
+
+func (c *Client) DetachItemDetailModifierGroup(ctx context.Context, itemDetailID, groupID int) error {
+	path := idPath(idPath("/item-detail", itemDetailID)+"/modifier-groups", groupID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
+
+// AttachCategoryModifierGroup attaches modifier group to all item details of category.
+func (c *Client) AttachCategoryModifierGroup(ctx context.Context, categoryID, groupID int) error {
+	return c.attachModifierGroup(ctx, idPath("/category", categoryID)+"/modifier-groups", groupID)
+}
+
+func (c *Client) DetachCategoryModifierGroup(ctx context.Context, categoryID, groupID int) error {
+	path := idPath(idPath("/category", categoryID)+"/modifier-groups", groupID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
+
+func (c *Client) attachModifierGroup(ctx context.Context, path string, groupID int) error {
+	req, err := jsonRequest(http.MethodPost, path, map[string]int{"modifier_group_id": groupID})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
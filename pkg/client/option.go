@@ -0,0 +1,38 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option -.
+type Option func(*Client)
+
+// HTTPClient sets the http client used for requests, e.g. with custom transport or timeout.
+func HTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// Retries sets how many times an idempotent request is retried on network errors and 5xx or 429 responses.
+func Retries(retries int) Option {
+	return func(c *Client) {
+		c.retries = retries
+	}
+}
+
+// RetryWait sets the wait before the first retry, it is doubled for every next retry.
+func RetryWait(wait time.Duration) Option {
+	return func(c *Client) {
+		c.retryWait = wait
+	}
+}
+
+// Language sets Accept-Language header of the requests, e.g. en or "zh-CN,zh;q=0.9,en;q=0.8".
+// Item, category and group names are returned in the best supported language, else in the default one.
+func Language(language string) Option {
+	return func(c *Client) {
+		c.language = language
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PromotionInput is the promotion of create and update.
+type PromotionInput struct {
+	PromotionName string     `json:"promotion_name"`
+	DiscountType  string     `json:"discount_type"`  // PromotionPercent, PromotionFixedAmount or PromotionBuyXGetY
+	DiscountValue float64    `json:"discount_value"` // percent or amount in the item detail currency, 0 for buy_x_get_y
+	BuyQuantity   int        `json:"buy_quantity"`   // buy_x_get_y only
+	GetQuantity   int        `json:"get_quantity"`   // buy_x_get_y only
+	TargetType    string     `json:"target_type"`    // PromotionTargetItem, PromotionTargetCategory or PromotionTargetGroup
+	TargetID      int        `json:"target_id"`
+	StartsAt      *time.Time `json:"starts_at,omitempty"`
+	EndsAt        *time.Time `json:"ends_at,omitempty"`  // exclusive
+	Weekdays      []int      `json:"weekdays,omitempty"` // 0 is Sunday, every day if empty
+	Stackable     bool       `json:"stackable"`
+	Priority      int        `json:"priority"` // stackable promotions are applied in ascending priority
+}
+
+// PromotionFilter filters the promotion list, nil fields are not used.
+type PromotionFilter struct {
+	TargetType *string
+	TargetID   *int
+}
+
+func (f *PromotionFilter) addQuery(query url.Values) {
+	if f == nil {
+		return
+	}
+
+	if f.TargetType != nil {
+		query.Set("target_type", *f.TargetType)
+	}
+	if f.TargetID != nil {
+		query.Set("target_id", strconv.Itoa(*f.TargetID))
+	}
+}
+
+// CreatePromotion creates promotion and returns its id.
+func (c *Client) CreatePromotion(ctx context.Context, promotion *PromotionInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/promotion", promotion)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetPromotion(ctx context.Context, id int) (*Promotion, error) {
+	var promotion Promotion
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/promotion", id)}, &promotion); err != nil {
+		return nil, err
+	}
+
+	return &promotion, nil
+}
+
+func (c *Client) ListPromotions(ctx context.Context, filter *PromotionFilter, page Page) (*PromotionPage, error) {
+	query := page.query()
+	filter.addQuery(query)
+
+	var res PromotionPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/promotion", query: query}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdatePromotion(ctx context.Context, id int, promotion *PromotionInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/promotion", id), promotion)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeletePromotion(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/promotion", id)}, nil)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// StockMovementInput is a movement of the item detail stock.
+// Quantity of receive, sell and waste is positive, of adjust it is the signed correction.
+type StockMovementInput struct {
+	Type     string  `json:"type"` // StockMovementReceive, StockMovementSell, StockMovementAdjust or StockMovementWaste
+	Quantity float64 `json:"quantity"`
+	Note     string  `json:"note,omitempty"`
+}
+
+// CreateStockMovement records the movement of the item detail stock
+// and returns its id with the on-hand quantity after it.
+func (c *Client) CreateStockMovement(ctx context.Context, itemDetailID int, input StockMovementInput) (id int, balance float64, err error) {
+	req, err := jsonRequest(http.MethodPost, idPath("/item-detail", itemDetailID)+"/stock/movements", input)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var res struct {
+		ID           int     `json:"id"`
+		BalanceAfter float64 `json:"balance_after"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, 0, err
+	}
+
+	return res.ID, res.BalanceAfter, nil
+}
+
+// ListStockMovements returns page of stock movements of the item detail, the latest first.
+func (c *Client) ListStockMovements(ctx context.Context, itemDetailID int, page Page) (*StockMovementPage, error) {
+	var res StockMovementPage
+	path := idPath("/item-detail", itemDetailID) + "/stock/movements"
+	if err := c.do(ctx, &request{method: http.MethodGet, path: path, query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetStockBalance returns the on-hand quantity of the item detail.
+func (c *Client) GetStockBalance(ctx context.Context, itemDetailID int) (*StockBalance, error) {
+	var balance StockBalance
+	path := idPath("/item-detail", itemDetailID) + "/stock"
+	if err := c.do(ctx, &request{method: http.MethodGet, path: path}, &balance); err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// ListStockBalances returns page of on-hand quantities of the item details having stock movements.
+func (c *Client) ListStockBalances(ctx context.Context, page Page) (*StockBalancePage, error) {
+	var res StockBalancePage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/stock", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
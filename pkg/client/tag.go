@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateTag creates tag and returns its id, the name is trimmed and lowercased.
+func (c *Client) CreateTag(ctx context.Context, name string) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/tag", map[string]string{"tag_name": name})
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetTag(ctx context.Context, id int) (*Tag, error) {
+	var tag Tag
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/tag", id)}, &tag); err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (c *Client) ListTags(ctx context.Context, page Page) (*TagPage, error) {
+	var res TagPage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/tag", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateTag(ctx context.Context, id int, name string) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/tag", id), map[string]string{"tag_name": name})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+// DeleteTag deletes tag and removes it from items.
+func (c *Client) DeleteTag(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/tag", id)}, nil)
+}
+
+func (c *Client) AddItemTag(ctx context.Context, itemID, tagID int) error {
+	req, err := jsonRequest(http.MethodPost, idPath("/item", itemID)+"/tags", map[string]int{"tag_id": tagID})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) RemoveItemTag(ctx context.Context, itemID, tagID int) error {
+	path := idPath(idPath("/item", itemID)+"/tags", tagID)
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// TaxProfileInput is the tax profile of create and update.
+type TaxProfileInput struct {
+	ProfileName          string  `json:"profile_name"`
+	VATPercent           float64 `json:"vat_percent"`
+	ServiceChargePercent float64 `json:"service_charge_percent"`
+	PriceIncludesTax     bool    `json:"price_includes_tax"` // price is gross if true, net otherwise
+}
+
+// CreateTaxProfile creates tax profile and returns its id.
+func (c *Client) CreateTaxProfile(ctx context.Context, profile *TaxProfileInput) (int, error) {
+	req, err := jsonRequest(http.MethodPost, "/tax-profile", profile)
+	if err != nil {
+		return 0, err
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+	if err := c.do(ctx, req, &res); err != nil {
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+func (c *Client) GetTaxProfile(ctx context.Context, id int) (*TaxProfile, error) {
+	var profile TaxProfile
+	if err := c.do(ctx, &request{method: http.MethodGet, path: idPath("/tax-profile", id)}, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (c *Client) ListTaxProfiles(ctx context.Context, page Page) (*TaxProfilePage, error) {
+	var res TaxProfilePage
+	if err := c.do(ctx, &request{method: http.MethodGet, path: "/tax-profile", query: page.query()}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) UpdateTaxProfile(ctx context.Context, id int, profile *TaxProfileInput) error {
+	req, err := jsonRequest(http.MethodPut, idPath("/tax-profile", id), profile)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) DeleteTaxProfile(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/tax-profile", id)}, nil)
+}
+
+// SetCategoryTaxProfile attaches tax profile to category, it is used before the group profile.
+func (c *Client) SetCategoryTaxProfile(ctx context.Context, categoryID, taxProfileID int) error {
+	return c.setTaxProfile(ctx, idPath("/category", categoryID)+"/tax-profile", taxProfileID)
+}
+
+func (c *Client) DeleteCategoryTaxProfile(ctx context.Context, categoryID int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/category", categoryID) + "/tax-profile"}, nil)
+}
+
+// SetGroupTaxProfile attaches tax profile to group, it is used if the category has no profile.
+func (c *Client) SetGroupTaxProfile(ctx context.Context, groupID, taxProfileID int) error {
+	return c.setTaxProfile(ctx, idPath("/group", groupID)+"/tax-profile", taxProfileID)
+}
+
+func (c *Client) DeleteGroupTaxProfile(ctx context.Context, groupID int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: idPath("/group", groupID) + "/tax-profile"}, nil)
+}
+
+func (c *Client) setTaxProfile(ctx context.Context, path string, taxProfileID int) error {
+	req, err := jsonRequest(http.MethodPut, path, map[string]int{"tax_profile_id": taxProfileID})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// export formats
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// ImportItemDetails imports item details from csv.
+// with dryRun the report shows the changes, but nothing is applied.
+func (c *Client) ImportItemDetails(ctx context.Context, csv io.Reader, dryRun bool) (*ItemDetailImportReport, error) {
+	body, err := io.ReadAll(csv)
+	if err != nil {
+		return nil, fmt.Errorf("read import file error: %w", err)
+	}
+
+	req := &request{
+		method:      http.MethodPost,
+		path:        "/import/item-details",
+		query:       url.Values{"dry_run": {strconv.FormatBool(dryRun)}},
+		body:        body,
+		contentType: "text/csv",
+	}
+
+	var report ItemDetailImportReport
+	if err := c.do(ctx, req, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// ExportItemDetails returns the file of item details by filter, the caller must close it.
+// format is ExportFormatCSV or ExportFormatXLSX, columns are all columns if empty.
+// the file is streamed, so the timeout of the http client limits the whole download.
+func (c *Client) ExportItemDetails(ctx context.Context, format string, columns []string, filter *ItemDetailFilter) (io.ReadCloser, error) {
+	query := url.Values{}
+	if format != "" {
+		query.Set("format", format)
+	}
+	if len(columns) > 0 {
+		query.Set("columns", strings.Join(columns, ","))
+	}
+	filter.addQuery(query)
+
+	resp, err := c.send(ctx, &request{method: http.MethodGet, path: "/export/item-details", query: query})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
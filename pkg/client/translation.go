@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// translationPath is the path of the translations of item, category or group name, e.g. /item/1/translations.
+func translationPath(prefix string, id int) string {
+	return idPath(prefix, id) + "/translations"
+}
+
+func (c *Client) translations(ctx context.Context, prefix string, id int) ([]*Translation, error) {
+	var res struct {
+		Translations []*Translation `json:"translations"`
+	}
+	if err := c.do(ctx, &request{method: http.MethodGet, path: translationPath(prefix, id)}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Translations, nil
+}
+
+func (c *Client) setTranslation(ctx context.Context, prefix string, id int, locale, name string) error {
+	path := fmt.Sprintf("%s/%s", translationPath(prefix, id), url.PathEscape(locale))
+	req, err := jsonRequest(http.MethodPut, path, map[string]string{"name": name})
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, nil)
+}
+
+func (c *Client) deleteTranslation(ctx context.Context, prefix string, id int, locale string) error {
+	path := fmt.Sprintf("%s/%s", translationPath(prefix, id), url.PathEscape(locale))
+	return c.do(ctx, &request{method: http.MethodDelete, path: path}, nil)
+}
+
+// ItemTranslations returns translations of the item name ordered by locale.
+func (c *Client) ItemTranslations(ctx context.Context, id int) ([]*Translation, error) {
+	return c.translations(ctx, "/item", id)
+}
+
+// SetItemTranslation creates or replaces translation of the item name to the locale, e.g. en.
+func (c *Client) SetItemTranslation(ctx context.Context, id int, locale, name string) error {
+	return c.setTranslation(ctx, "/item", id, locale, name)
+}
+
+func (c *Client) DeleteItemTranslation(ctx context.Context, id int, locale string) error {
+	return c.deleteTranslation(ctx, "/item", id, locale)
+}
+
+// CategoryTranslations returns translations of the category name ordered by locale.
+func (c *Client) CategoryTranslations(ctx context.Context, id int) ([]*Translation, error) {
+	return c.translations(ctx, "/category", id)
+}
+
+// SetCategoryTranslation creates or replaces translation of the category name to the locale, e.g. en.
+func (c *Client) SetCategoryTranslation(ctx context.Context, id int, locale, name string) error {
+	return c.setTranslation(ctx, "/category", id, locale, name)
+}
+
+func (c *Client) DeleteCategoryTranslation(ctx context.Context, id int, locale string) error {
+	return c.deleteTranslation(ctx, "/category", id, locale)
+}
+
+// GroupTranslations returns translations of the group name ordered by locale.
+func (c *Client) GroupTranslations(ctx context.Context, id int) ([]*Translation, error) {
+	return c.translations(ctx, "/group", id)
+}
+
+// SetGroupTranslation creates or replaces translation of the group name to the locale, e.g. en.
+func (c *Client) SetGroupTranslation(ctx context.Context, id int, locale, name string) error {
+	return c.setTranslation(ctx, "/group", id, locale, name)
+}
+
+func (c *Client) DeleteGroupTranslation(ctx context.Context, id int, locale string) error {
+	return c.deleteTranslation(ctx, "/group", id, locale)
+}
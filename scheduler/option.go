@@ -0,0 +1,23 @@
+package scheduler
+
+import "time"
+
+// Option -.
+type Option func(*Scheduler)
+
+// Interval -.
+func Interval(d time.Duration) Option {
+	return func(s *Scheduler) {
+		// a non-positive interval would run the job in a tight loop, keep the default
+		if d > 0 {
+			s.interval = d
+		}
+	}
+}
+
+// ShutdownTimeout -.
+func ShutdownTimeout(t time.Duration) Option {
+	return func(s *Scheduler) {
+		s.shutdownTimeout = t
+	}
+}
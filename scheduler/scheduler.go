@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	_defaultInterval        = 10 * time.Second
+	_defaultShutdownTimeout = 3 * time.Second
+)
+
+// Scheduler runs the job in background, at start and then every interval.
+// The next run starts an interval after the previous one ends, so runs do not overlap.
+type Scheduler struct {
+	job             func(ctx context.Context)
+	interval        time.Duration
+	shutdownTimeout time.Duration
+	cancel          context.CancelFunc
+	done            chan struct{}
+}
+
+// New creates and starts the scheduler of the job.
+func New(job func(ctx context.Context), opts ...Option) *Scheduler {
+	s := &Scheduler{
+		job:             job,
+		interval:        _defaultInterval,
+		shutdownTimeout: _defaultShutdownTimeout,
+		done:            make(chan struct{}),
+	}
+
+	// custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.start()
+
+	return s
+}
+
+func (s *Scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.done)
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			s.job(ctx)
+			timer.Reset(s.interval)
+		}
+	}()
+}
+
+// Shutdown stops the scheduler, the running job is cancelled and waited for the shutdown timeout.
+func (s *Scheduler) Shutdown() {
+	s.cancel()
+
+	select {
+	case <-s.done:
+	case <-time.After(s.shutdownTimeout):
+	}
+}
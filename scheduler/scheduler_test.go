@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerRunsAtStart(t *testing.T) {
+	ran := make(chan struct{}, 1)
+	s := New(func(ctx context.Context) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	}, Interval(time.Hour))
+	defer s.Shutdown()
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run at start")
+	}
+}
+
+func TestSchedulerNonPositiveInterval(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Second} {
+		var (
+			mu   sync.Mutex
+			runs int
+		)
+		s := New(func(ctx context.Context) {
+			mu.Lock()
+			runs++
+			mu.Unlock()
+		}, Interval(d))
+
+		if s.interval != _defaultInterval {
+			t.Errorf("Interval(%s) set interval %s, want the default %s", d, s.interval, _defaultInterval)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		s.Shutdown()
+
+		mu.Lock()
+		if runs != 1 {
+			t.Errorf("Interval(%s) ran the job %d times in 50ms, want once at start", d, runs)
+		}
+		mu.Unlock()
+	}
+}
+
+func TestSchedulerRunsDoNotOverlap(t *testing.T) {
+	const (
+		interval = 20 * time.Millisecond
+		duration = 30 * time.Millisecond
+		runs     = 4
+	)
+
+	var (
+		mu      sync.Mutex
+		running bool
+		starts  []time.Time
+		ends    []time.Time
+	)
+	done := make(chan struct{})
+	s := New(func(ctx context.Context) {
+		mu.Lock()
+		if running {
+			t.Error("job started while the previous run is running")
+		}
+		running = true
+		starts = append(starts, time.Now())
+		mu.Unlock()
+
+		// the run takes longer than the interval
+		time.Sleep(duration)
+
+		mu.Lock()
+		running = false
+		ends = append(ends, time.Now())
+		if len(ends) == runs {
+			close(done)
+		}
+		mu.Unlock()
+	}, Interval(interval))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("job did not run %d times", runs)
+	}
+	s.Shutdown()
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 1; i < runs; i++ {
+		if gap := starts[i].Sub(ends[i-1]); gap < interval {
+			t.Errorf("run %d started %s after the previous run ended, want at least %s", i, gap, interval)
+		}
+	}
+}
+
+func TestSchedulerShutdownCancelsJob(t *testing.T) {
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	s := New(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+	}, Interval(time.Hour), ShutdownTimeout(5*time.Second))
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run at start")
+	}
+
+	begin := time.Now()
+	s.Shutdown()
+	if elapsed := time.Since(begin); elapsed >= time.Second {
+		t.Errorf("shutdown took %s, want the cancelled job to return at once", elapsed)
+	}
+
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("job context was not cancelled by shutdown")
+	}
+	select {
+	case <-s.done:
+	default:
+		t.Fatal("scheduler is still running after shutdown")
+	}
+}
+
+func TestSchedulerShutdownTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	s := New(func(ctx context.Context) {
+		close(started)
+		// the job ignores cancellation
+		<-release
+	}, Interval(time.Hour), ShutdownTimeout(50*time.Millisecond))
+
+	<-started
+
+	begin := time.Now()
+	s.Shutdown()
+	if elapsed := time.Since(begin); elapsed < 50*time.Millisecond || elapsed >= time.Second {
+		t.Errorf("shutdown took %s, want the shutdown timeout of 50ms", elapsed)
+	}
+}
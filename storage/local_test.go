@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "blobs")
+
+	s, err := NewLocal(root)
+	if err != nil {
+		t.Fatalf("new local storage: %v", err)
+	}
+
+	testStorage(t, s)
+
+	// invalid keys must not write outside of the root
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != "blobs" {
+			t.Errorf("unexpected file %s outside of the root", entry.Name())
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestS3 needs S3 compatible storage, e.g. local MinIO:
+//
+//	docker run -p 9000:9000 minio/minio server /data
+//	STORAGE_TEST_S3_ENDPOINT=localhost:9000 go test ./storage
+//
+// The credentials are minioadmin by default, the bucket is created if it does not exist.
+func TestS3(t *testing.T) {
+	endpoint := os.Getenv("STORAGE_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("STORAGE_TEST_S3_ENDPOINT is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s, err := NewS3(ctx, S3Options{
+		Endpoint:  endpoint,
+		Bucket:    envOr("STORAGE_TEST_S3_BUCKET", fmt.Sprintf("storage-test-%d", time.Now().UnixNano())),
+		AccessKey: envOr("STORAGE_TEST_S3_ACCESS_KEY", "minioadmin"),
+		SecretKey: envOr("STORAGE_TEST_S3_SECRET_KEY", "minioadmin"),
+		PathStyle: true,
+	})
+	if err != nil {
+		t.Fatalf("new s3 storage: %v", err)
+	}
+
+	testStorage(t, s)
+}
+
+func envOr(key, value string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	return value
+}
@@ -0,0 +1,24 @@
+// Package storage stores blobs, such as item images, by key.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Storage -.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error // store blob of the size by key, replacing the existing one
+	Get(ctx context.Context, key string) (io.ReadCloser, error)                             // open blob by key, ErrNotFound if it does not exist
+	Delete(ctx context.Context, key string) error                                           // delete blob by key, deleting missing blob is not an error
+}
+
+// validKey checks that the key is a clean relative slash separated path, e.g. items/1/image.jpg.
+func validKey(key string) bool {
+	return key != "" && path.Clean(key) == key && !path.IsAbs(key) && !strings.HasPrefix(key, "../") && key != ".."
+}
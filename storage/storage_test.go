@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+var _invalidKeys = []string{"", "/items/1/image.jpg", "..", "../image.jpg", "items/../../image.jpg", "items//image.jpg", "./image.jpg", "items/"}
+
+// testStorage checks the put, get and delete round trip, missing keys and invalid keys of the storage.
+func testStorage(t *testing.T, s Storage) {
+	ctx := context.Background()
+	key := "items/1/image.jpg"
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, data := range [][]byte{[]byte("first"), []byte("second, replacing the first")} {
+			if err := s.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "image/jpeg"); err != nil {
+				t.Fatalf("put: %v", err)
+			}
+
+			r, err := s.Get(ctx, key)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			got, err := io.ReadAll(r)
+			r.Close()
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatalf("get = %q, want %q", got, data)
+			}
+		}
+
+		if err := s.Delete(ctx, key); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := s.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("get after delete error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		if _, err := s.Get(ctx, "items/0/missing.jpg"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("get error = %v, want ErrNotFound", err)
+		}
+		if err := s.Delete(ctx, "items/0/missing.jpg"); err != nil {
+			t.Errorf("delete error = %v, want nil", err)
+		}
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range _invalidKeys {
+			data := []byte("data")
+			if err := s.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "image/jpeg"); err == nil {
+				t.Errorf("put %q: expected error", key)
+			}
+			if r, err := s.Get(ctx, key); err == nil || errors.Is(err, ErrNotFound) {
+				if r != nil {
+					r.Close()
+				}
+				t.Errorf("get %q error = %v, want invalid key error", key, err)
+			}
+			if err := s.Delete(ctx, key); err == nil {
+				t.Errorf("delete %q: expected error", key)
+			}
+		}
+	})
+}